@@ -292,7 +292,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -303,14 +303,22 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 //go:embed "schema.graphql"
@@ -2489,7 +2497,7 @@ func (ec *executionContext) _Chatroom(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2548,7 +2556,7 @@ func (ec *executionContext) _Message(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2597,7 +2605,7 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2666,7 +2674,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2742,7 +2750,7 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2790,7 +2798,7 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2848,7 +2856,7 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2903,7 +2911,7 @@ func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.Selection
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2958,7 +2966,7 @@ func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3017,7 +3025,7 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3110,9 +3118,11 @@ func (ec *executionContext) marshalNMessage2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3216,9 +3226,11 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3291,9 +3303,11 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3347,9 +3361,11 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3395,9 +3411,11 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3523,9 +3541,11 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3570,9 +3590,11 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3617,9 +3639,11 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3671,9 +3695,11 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
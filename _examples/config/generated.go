@@ -281,7 +281,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -292,14 +292,22 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 //go:embed "schema.graphql" "todo.graphql" "user.graphql"
@@ -2472,7 +2480,7 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2544,7 +2552,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2644,7 +2652,7 @@ func (ec *executionContext) _Todo(ctx context.Context, sel ast.SelectionSet, obj
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2693,7 +2701,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2749,7 +2757,7 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2797,7 +2805,7 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2855,7 +2863,7 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2910,7 +2918,7 @@ func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.Selection
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2965,7 +2973,7 @@ func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3024,7 +3032,7 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3094,7 +3102,7 @@ func (ec *executionContext) _role(ctx context.Context, sel ast.SelectionSet, obj
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3244,9 +3252,11 @@ func (ec *executionContext) marshalNTodo2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3312,9 +3322,11 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3387,9 +3399,11 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3443,9 +3457,11 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3491,9 +3507,11 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3587,9 +3605,11 @@ func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel
 		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
 	}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3652,9 +3672,11 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3699,9 +3721,11 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3746,9 +3770,11 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3800,9 +3826,11 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -181,7 +181,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -192,14 +192,22 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 //go:embed "schema.graphql"
@@ -2056,7 +2064,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2100,7 +2108,7 @@ func (ec *executionContext) _Todo(ctx context.Context, sel ast.SelectionSet, obj
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2167,7 +2175,7 @@ func (ec *executionContext) _TodoContext(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2223,7 +2231,7 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2271,7 +2279,7 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2329,7 +2337,7 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2384,7 +2392,7 @@ func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.Selection
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2439,7 +2447,7 @@ func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2498,7 +2506,7 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2615,9 +2623,11 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2690,9 +2700,11 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2746,9 +2758,11 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2794,9 +2808,11 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2915,9 +2931,11 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2962,9 +2980,11 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3009,9 +3029,11 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3063,9 +3085,11 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
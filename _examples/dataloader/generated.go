@@ -283,7 +283,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -294,14 +294,22 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 //go:embed "schema.graphql"
@@ -2513,7 +2521,7 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2623,7 +2631,7 @@ func (ec *executionContext) _Customer(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2662,7 +2670,7 @@ func (ec *executionContext) _Item(ctx context.Context, sel ast.SelectionSet, obj
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2744,7 +2752,7 @@ func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, ob
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2851,7 +2859,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2907,7 +2915,7 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2955,7 +2963,7 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3013,7 +3021,7 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3068,7 +3076,7 @@ func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.Selection
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3123,7 +3131,7 @@ func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3182,7 +3190,7 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -3353,9 +3361,11 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3428,9 +3438,11 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3484,9 +3496,11 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3532,9 +3546,11 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3683,9 +3699,11 @@ func (ec *executionContext) marshalOCustomer2ᚕᚖgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3719,9 +3737,11 @@ func (ec *executionContext) marshalOInt2ᚕintᚄ(ctx context.Context, sel ast.S
 		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
 	}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3796,9 +3816,11 @@ func (ec *executionContext) marshalOItem2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3843,9 +3865,11 @@ func (ec *executionContext) marshalOOrder2ᚕᚖgithubᚗcomᚋ99designsᚋgqlge
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3908,9 +3932,11 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3955,9 +3981,11 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -4002,9 +4030,11 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -4056,9 +4086,11 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -86,7 +86,7 @@ func (ec *executionContext) __Service(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
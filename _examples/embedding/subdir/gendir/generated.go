@@ -181,7 +181,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -192,14 +192,22 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 var sources = []*ast.Source{
@@ -2134,7 +2142,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2170,7 +2178,7 @@ func (ec *executionContext) __Service(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2226,7 +2234,7 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2274,7 +2282,7 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2332,7 +2340,7 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2387,7 +2395,7 @@ func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.Selection
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2442,7 +2450,7 @@ func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet,
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2501,7 +2509,7 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -2614,9 +2622,11 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2689,9 +2699,11 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2745,9 +2757,11 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2793,9 +2807,11 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2926,9 +2942,11 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -2973,9 +2991,11 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3020,9 +3040,11 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
@@ -3074,9 +3096,11 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+	if graphql.PropagatesErrors(ctx) {
+		for _, e := range ret {
+			if e == graphql.Null {
+				return graphql.Null
+			}
 		}
 	}
 
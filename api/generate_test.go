@@ -3,8 +3,10 @@ package api
 import (
 	"errors"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/ast"
@@ -128,3 +130,94 @@ func TestGenerateWithSchemaMutator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateIncremental(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "incremental")
+	userSchema := filepath.Join(workDir, "graph", "user.graphqls")
+	original, err := os.ReadFile(userSchema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.WriteFile(userSchema, original, 0o644)
+		generated, _ := filepath.Glob(filepath.Join(workDir, "graph", "*.go"))
+		for _, f := range generated {
+			_ = os.Remove(f)
+		}
+		_ = os.Remove(filepath.Join(workDir, "graph", "model", "models_gen.go"))
+		t.Chdir(wd)
+	})
+	t.Chdir(workDir)
+
+	generate := func() {
+		cfg, err := config.LoadConfigFromDefaultLocations()
+		require.NoError(t, err)
+		cfg.SkipModTidy = true
+		require.NoError(t, Generate(cfg))
+	}
+
+	// writes are detected by the modification time moving away from this one
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	written := func() []string {
+		files, err := filepath.Glob(filepath.Join("graph", "*.generated.go"))
+		require.NoError(t, err)
+		var res []string
+		for _, f := range files {
+			info, err := os.Stat(f)
+			require.NoError(t, err)
+			if !info.ModTime().Equal(past) {
+				res = append(res, filepath.Base(f))
+			}
+			require.NoError(t, os.Chtimes(f, past, past))
+		}
+		return res
+	}
+
+	generate()
+	require.Equal(t, []string{
+		"prelude.generated.go",
+		"root_.generated.go",
+		"todo.generated.go",
+		"user.generated.go",
+	}, written())
+
+	generate()
+	require.Empty(t, written())
+
+	require.NoError(t, os.WriteFile(userSchema, append(original, "\nextend type User {\n  admin: Boolean!\n}\n"...), 0o644))
+	generate()
+	require.Equal(t, []string{"root_.generated.go", "user.generated.go"}, written())
+}
+
+func TestGenerateConnections(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "connections")
+	resolvers := filepath.Join(workDir, "graph", "schema.resolvers.go")
+	original, err := os.ReadFile(resolvers)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.WriteFile(resolvers, original, 0o644)
+		_ = os.Remove(filepath.Join(workDir, "graph", "generated.go"))
+		_ = os.Remove(filepath.Join(workDir, "graph", "model", "models_gen.go"))
+		t.Chdir(wd)
+	})
+	t.Chdir(workDir)
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err)
+	cfg.SkipModTidy = true
+	require.NoError(t, Generate(cfg))
+
+	models, err := os.ReadFile(filepath.Join("graph", "model", "models_gen.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(models), "type UserConnection = graphql.Connection[*User]")
+	require.NotContains(t, string(models), "type PageInfo struct")
+
+	// the resolver returning graphql.NewConnection is kept and compiles against the generated code
+	generated, err := os.ReadFile(resolvers)
+	require.NoError(t, err)
+	require.Contains(t, string(generated), "graphql.NewConnection(")
+	out, err := exec.Command("go", "vet", "./graph/...").CombinedOutput()
+	require.NoError(t, err, string(out))
+}
@@ -0,0 +1,7 @@
+package graph
+
+import "github.com/99designs/gqlgen/api/testdata/connections/graph/model"
+
+type Resolver struct {
+	Users []*model.User
+}
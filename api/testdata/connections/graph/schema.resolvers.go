@@ -0,0 +1,23 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.84-dev
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/api/testdata/connections/graph/model"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.Connection[*model.User], error) {
+	return graphql.NewConnection(r.Resolver.Users, graphql.ConnectionArgs{First: first, After: after, Last: last, Before: before})
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
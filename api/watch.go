@@ -0,0 +1,319 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// Watcher runs Generate again whenever the config, the schema or the Go packages models are bound
+// from change. Files are polled, so it works on any file system, including network mounts and
+// containers where change notifications are unreliable.
+//
+// Loaded Go packages are kept between runs, only the packages a changed file belongs to are
+// evicted, together with the packages connected to them.
+type Watcher struct {
+	// Load reads the config, it is called before every run so changes to it are picked up.
+	Load func() (*config.Config, error)
+	// ConfigFilename is watched for changes when set.
+	ConfigFilename string
+	// Options are passed to Generate.
+	Options []Option
+	// Interval between polls of the watched files, it defaults to 500ms.
+	Interval time.Duration
+	// Debounce is how long files have to stay unchanged before generating, so a burst of writes,
+	// like a branch checkout, leads to a single run. It defaults to 200ms.
+	Debounce time.Duration
+	// Out receives progress and errors, it defaults to os.Stderr.
+	Out io.Writer
+
+	packages  *code.Packages
+	buildTags string
+	dirs      map[string]map[string]bool
+	outputs   []string
+}
+
+type fileState struct {
+	modTime   time.Time
+	size      int64
+	generated bool
+}
+
+// Run generates once and then on every change until ctx is done.
+func (w *Watcher) Run(ctx context.Context) error {
+	if w.Interval == 0 {
+		w.Interval = 500 * time.Millisecond
+	}
+	if w.Debounce == 0 {
+		w.Debounce = 200 * time.Millisecond
+	}
+	if w.Out == nil {
+		w.Out = os.Stderr
+	}
+
+	w.generate(nil)
+	files := w.snapshot(nil)
+	fmt.Fprintf(w.Out, "watching %d files for changes\n", len(files))
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	var changed []string
+	var lastChange time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next := w.snapshot(files)
+		if diff := changedFiles(files, next); len(diff) > 0 {
+			changed = append(changed, diff...)
+			lastChange = time.Now()
+		}
+		files = next
+
+		if len(changed) == 0 || time.Since(lastChange) < w.Debounce {
+			continue
+		}
+
+		watched := w.dirs
+		w.generate(dedupe(changed))
+
+		// files edited while generating are picked up by the next run, files appearing because
+		// the set of watched directories changed are not changes.
+		next = w.snapshot(files)
+		changed = nil
+		for _, f := range changedFiles(files, next) {
+			if watched[filepath.Dir(f)] != nil {
+				changed = append(changed, f)
+				lastChange = time.Now()
+			}
+		}
+		files = next
+	}
+}
+
+// generate runs Generate, evicting the packages the changed files belong to first.
+func (w *Watcher) generate(changed []string) {
+	start := time.Now()
+	if changed != nil {
+		fmt.Fprintf(w.Out, "regenerating, changed: %s\n", strings.Join(relativePaths(changed), ", "))
+	}
+
+	cfg, err := w.Load()
+	if err != nil {
+		fmt.Fprintln(w.Out, err)
+		if w.dirs == nil {
+			w.dirs = w.watchedDirs(&config.Config{})
+		}
+		return
+	}
+
+	configChanged := false
+	for _, f := range changed {
+		if w.ConfigFilename != "" && f == absPath(w.ConfigFilename) {
+			configChanged = true
+		}
+	}
+	buildTags := strings.Join(cfg.GoBuildTags, ",")
+	if w.packages == nil || configChanged || buildTags != w.buildTags {
+		w.packages = code.NewPackages(
+			code.WithBuildTags(cfg.GoBuildTags...),
+			code.PackagePrefixToCache("github.com/99designs/gqlgen/graphql"),
+			code.WithSelectiveReload(),
+		)
+		w.buildTags = buildTags
+	} else {
+		for _, f := range changed {
+			if filepath.Ext(f) == ".go" {
+				w.packages.Evict(code.ImportPathForDir(filepath.Dir(f)))
+			}
+		}
+		// Generate starts by removing the files it writes, the packages holding them are stale.
+		for _, importPath := range w.outputs {
+			w.packages.Evict(importPath)
+		}
+	}
+	w.packages.ClearErrors()
+
+	cfg.Packages = w.packages
+	// go mod tidy only needs to run once, it also empties the package cache.
+	if changed != nil {
+		cfg.SkipModTidy = true
+	}
+
+	err = Generate(cfg, w.Options...)
+	w.dirs = w.watchedDirs(cfg)
+	w.outputs = nil
+	if cfg.Exec.Layout != "" {
+		w.outputs = append(w.outputs, cfg.Exec.GetImportPath())
+	}
+	if cfg.Model.IsDefined() {
+		w.outputs = append(w.outputs, cfg.Model.GetImportPath())
+	}
+	if err != nil {
+		fmt.Fprintln(w.Out, err)
+		return
+	}
+	fmt.Fprintf(w.Out, "generated in %s\n", time.Since(start).Round(time.Millisecond))
+}
+
+// watchedDirs returns the directories to poll, with the file extensions to look at in each.
+func (w *Watcher) watchedDirs(cfg *config.Config) map[string]map[string]bool {
+	dirs := map[string]map[string]bool{}
+	add := func(dir, ext string) {
+		dir = absPath(dir)
+		if dirs[dir] == nil {
+			dirs[dir] = map[string]bool{}
+		}
+		dirs[dir][ext] = true
+	}
+
+	for _, f := range cfg.SchemaFilename {
+		if config.IsSchemaEndpoint(f) {
+			continue
+		}
+		add(filepath.Dir(f), filepath.Ext(f))
+	}
+	if cfg.Packages == nil {
+		return dirs
+	}
+	for _, importPath := range append(cfg.Models.ReferencedPackages(), cfg.AutoBind...) {
+		pkg := cfg.Packages.Load(importPath)
+		// only packages of the main module can change while watching
+		if pkg == nil || pkg.Module == nil || !pkg.Module.Main || len(pkg.GoFiles) == 0 {
+			continue
+		}
+		add(filepath.Dir(pkg.GoFiles[0]), ".go")
+	}
+	return dirs
+}
+
+// snapshot stats every watched file, reusing what is known about unchanged files from prev.
+func (w *Watcher) snapshot(prev map[string]fileState) map[string]fileState {
+	files := map[string]fileState{}
+	for dir, exts := range w.dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !exts[filepath.Ext(name)] || strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+
+			path := filepath.Join(dir, name)
+			state := fileState{modTime: info.ModTime(), size: info.Size()}
+			if p, ok := prev[path]; ok && p.modTime.Equal(state.modTime) && p.size == state.size {
+				state.generated = p.generated
+			} else {
+				state.generated = isGenerated(path)
+			}
+			files[path] = state
+		}
+	}
+	if w.ConfigFilename != "" {
+		// only the config itself is watched, not the files next to it
+		path := absPath(w.ConfigFilename)
+		if info, err := os.Stat(path); err == nil {
+			files[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return files
+}
+
+// changedFiles returns the files added, removed or modified between two snapshots, ignoring the
+// files written by gqlgen itself.
+func changedFiles(prev, next map[string]fileState) []string {
+	var changed []string
+	for path, state := range next {
+		if state.generated {
+			continue
+		}
+		if p, ok := prev[path]; !ok || !p.modTime.Equal(state.modTime) || p.size != state.size {
+			changed = append(changed, path)
+		}
+	}
+	for path, state := range prev {
+		if _, ok := next[path]; !ok && !state.generated {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+var generatedHeader = regexp.MustCompile(
+	`^// (Code generated .* DO NOT EDIT\.|This file will be automatically regenerated|THIS CODE WILL BE UPDATED WITH SCHEMA CHANGES)`,
+)
+
+// isGenerated reports whether one of the first lines of the file is a header written by gqlgen
+// or another generator, those files are outputs rather than inputs.
+func isGenerated(path string) bool {
+	if filepath.Ext(path) != ".go" {
+		return false
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// gqlgen puts the notice of resolver files below the package clause
+	for i := 0; i < 10 && scanner.Scan(); i++ {
+		if generatedHeader.MatchString(strings.TrimSpace(scanner.Text())) {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(paths []string) []string {
+	sort.Strings(paths)
+	res := paths[:0]
+	for i, p := range paths {
+		if i == 0 || p != paths[i-1] {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func relativePaths(paths []string) []string {
+	wd, _ := os.Getwd()
+	res := make([]string, len(paths))
+	for i, p := range paths {
+		if rel, err := filepath.Rel(wd, p); err == nil {
+			res[i] = rel
+		} else {
+			res[i] = p
+		}
+	}
+	return res
+}
+
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return abs
+}
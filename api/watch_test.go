@@ -0,0 +1,128 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatcher(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "default")
+	t.Cleanup(func() {
+		cleanup(workDir)
+		t.Chdir(wd)
+	})
+	t.Chdir(workDir)
+
+	out := &syncBuffer{}
+	w := &Watcher{
+		Load: func() (*config.Config, error) {
+			cfg, err := config.LoadConfigFromDefaultLocations()
+			if err != nil {
+				return nil, err
+			}
+			cfg.SkipModTidy = true
+			return cfg, nil
+		},
+		ConfigFilename: filepath.Join(workDir, "gqlgen.yml"),
+		Interval:       10 * time.Millisecond,
+		Debounce:       20 * time.Millisecond,
+		Out:            out,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return strings.Contains(out.String(), "watching")
+	}, time.Minute, 10*time.Millisecond)
+	require.Contains(t, out.String(), "generated in", out.String())
+
+	// touching the schema regenerates, the files written by generating do not
+	schema := filepath.Join(workDir, "graph", "schema.graphqls")
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(schema, later, later))
+
+	require.Eventually(t, func() bool {
+		return strings.Count(out.String(), "generated in") == 2
+	}, time.Minute, 10*time.Millisecond, out.String())
+	require.Contains(t, out.String(), "regenerating, changed: graph/schema.graphqls\n")
+
+	// so does changing a bound package, which is loaded again
+	later = later.Add(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(workDir, "graph", "model", "doc.go"), later, later))
+
+	require.Eventually(t, func() bool {
+		return strings.Count(out.String(), "generated in") == 3
+	}, time.Minute, 10*time.Millisecond, out.String())
+	require.Contains(t, out.String(), "regenerating, changed: graph/model/doc.go\n")
+
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+	require.Equal(t, 3, strings.Count(out.String(), "generated in"), out.String())
+	require.NotContains(t, out.String(), "failed", out.String())
+}
+
+func TestChangedFiles(t *testing.T) {
+	now := time.Now()
+	prev := map[string]fileState{
+		"same.go":      {modTime: now, size: 1},
+		"modified.go":  {modTime: now, size: 1},
+		"removed.go":   {modTime: now, size: 1},
+		"generated.go": {modTime: now, size: 1, generated: true},
+	}
+	next := map[string]fileState{
+		"same.go":      {modTime: now, size: 1},
+		"modified.go":  {modTime: now.Add(time.Second), size: 1},
+		"added.go":     {modTime: now, size: 1},
+		"generated.go": {modTime: now.Add(time.Second), size: 2, generated: true},
+	}
+	require.Equal(t, []string{"added.go", "modified.go", "removed.go"}, changedFiles(prev, next))
+}
+
+func TestIsGenerated(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+
+	require.True(t, isGenerated(write("exec.go",
+		"// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage graph\n")))
+	require.True(t, isGenerated(write("schema.resolvers.go",
+		"package graph\n\n// This file will be automatically regenerated based on the schema\n")))
+	require.False(t, isGenerated(write("model.go",
+		"// Package model is generated by hand.\npackage model\n")))
+	require.False(t, isGenerated(write("schema.graphqls", "# Code generated by x DO NOT EDIT.\n")))
+}
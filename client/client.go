@@ -112,14 +112,19 @@ func (p *Client) RawPost(query string, options ...Option) (*Response, error) {
 var boundaryRegex = regexp.MustCompile(`multipart/form-data; ?boundary=.*`)
 
 func (p *Client) newRequest(query string, options ...Option) (*http.Request, error) {
+	return buildRequest(httptest.NewRequest(http.MethodPost, p.target, http.NoBody), p.opts, query, options...)
+}
+
+// buildRequest applies the client options, then the request options, to r and encodes the body.
+func buildRequest(r *http.Request, clientOptions []Option, query string, options ...Option) (*http.Request, error) {
 	bd := &Request{
 		Query: query,
-		HTTP:  httptest.NewRequest(http.MethodPost, p.target, http.NoBody),
+		HTTP:  r,
 	}
 	bd.HTTP.Header.Set("Content-Type", "application/json")
 
 	// per client options from client.New apply first
-	for _, option := range p.opts {
+	for _, option := range clientOptions {
 		option(bd)
 	}
 	// per request options
@@ -137,6 +142,7 @@ func (p *Client) newRequest(query string, options ...Option) (*http.Request, err
 			return nil, fmt.Errorf("encode: %w", err)
 		}
 		bd.HTTP.Body = io.NopCloser(bytes.NewBuffer(requestBody))
+		bd.HTTP.ContentLength = int64(len(requestBody))
 	default:
 		panic("unsupported encoding " + bd.HTTP.Header.Get("Content-Type"))
 	}
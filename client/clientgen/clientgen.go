@@ -0,0 +1,727 @@
+// Package clientgen generates typed Go clients from GraphQL operation files, it backs the
+// `gqlgen client` command.
+//
+// Every named query and mutation becomes a function taking its variables as arguments and
+// returning a response struct that mirrors the selection set:
+//
+//   - objects become structs named after the operation and the path of the field,
+//   - interfaces and unions become Go interfaces, with one struct per possible type,
+//   - named fragments become structs that are embedded where they are spread,
+//   - enums and input objects become Go types of the same name,
+//   - scalars use the Go types bound in the models section of the config.
+//
+// The generated functions send requests through a client.Doer, so the same client works against
+// an http.Handler in tests and a remote endpoint in production.
+package clientgen
+
+import (
+	_ "embed"
+	"fmt"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+//go:embed client.gotpl
+var clientTemplate string
+
+// Generate writes the client configured in the client section of cfg. The config must have been
+// initialised with cfg.Init.
+func Generate(cfg *config.Config) error {
+	if err := cfg.Client.Check(); err != nil {
+		return fmt.Errorf("config.client: %w", err)
+	}
+
+	doc, err := loadOperations(cfg.Schema, cfg.Client.Operations)
+	if err != nil {
+		return err
+	}
+
+	b := &builder{
+		cfg:    cfg,
+		binder: cfg.NewBinder(),
+		pkg:    types.NewPackage(cfg.Client.GetImportPath(), cfg.Client.Package),
+		names:  map[string]bool{},
+		refs:   map[string]*Ref{},
+		data:   &Data{},
+	}
+	if err := b.build(doc); err != nil {
+		return err
+	}
+
+	return templates.Render(templates.Options{
+		PackageName:     cfg.Client.Package,
+		ImportPath:      cfg.Client.GetImportPath(),
+		Filename:        cfg.Client.Filename,
+		Template:        clientTemplate,
+		Data:            b.data,
+		GeneratedHeader: true,
+		Packages:        cfg.Packages,
+	})
+}
+
+func loadOperations(schema *ast.Schema, filenames []string) (*ast.QueryDocument, error) {
+	doc := &ast.QueryDocument{}
+	for _, filename := range filenames {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open operations: %w", err)
+		}
+		d, err := parser.ParseQuery(&ast.Source{Name: filename, Input: string(b)})
+		if err != nil {
+			return nil, err
+		}
+		doc.Operations = append(doc.Operations, d.Operations...)
+		doc.Fragments = append(doc.Fragments, d.Fragments...)
+	}
+
+	if errs := validator.ValidateWithRules(schema, doc, nil); len(errs) > 0 {
+		return nil, errs
+	}
+	return doc, nil
+}
+
+type (
+	// Data is passed to the client template.
+	Data struct {
+		Operations []*Operation
+		Structs    []*Struct
+		Interfaces []*Interface
+		Enums      []*Enum
+		Inputs     []*Input
+		// Refs are the types read from responses, each has an unmarshal function.
+		Refs []*Ref
+		// InputRefs are the types sent as variables, each has a marshal function.
+		InputRefs []*Ref
+	}
+
+	Operation struct {
+		Name      string
+		GoName    string
+		Kind      ast.Operation
+		Document  string
+		Variables []*Variable
+		Response  *Struct
+		def       *ast.OperationDefinition
+	}
+
+	Variable struct {
+		Name  string
+		Param string
+		Ref   *Ref
+	}
+
+	// Struct is the Go struct of a selection set on an object type, or of a named fragment.
+	Struct struct {
+		Name        string
+		Description string
+		// TypeName is the GraphQL type of the object, for structs implementing an Interface.
+		TypeName   string
+		Fields     []*Field
+		Embeds     []*Struct
+		Implements []*Interface
+		Ref        *Ref
+	}
+
+	Field struct {
+		GoName string
+		// Key is the response key, the alias or the name of the field.
+		Key string
+		Ref *Ref
+	}
+
+	// Interface is the Go interface of a selection set on an interface or union type.
+	Interface struct {
+		Name        string
+		Description string
+		// Getters are the leaf fields selected on the abstract type, shared by all
+		// implementations.
+		Getters         []*Field
+		Implementations []*Struct
+	}
+
+	Enum struct {
+		Name        string
+		Description string
+		Values      []*EnumValue
+		typ         types.Type
+	}
+
+	EnumValue struct {
+		Name   string
+		GoName string
+	}
+
+	Input struct {
+		Name        string
+		Description string
+		Fields      []*InputField
+		typ         types.Type
+	}
+
+	InputField struct {
+		Name   string
+		GoName string
+		Ref    *Ref
+	}
+
+	// Ref is a Go type together with the GraphQL type it represents.
+	Ref struct {
+		GO   types.Type
+		GQL  *ast.Type
+		Func string
+		// Elem is the element of a list, or the value a pointer points to.
+		Elem      *Ref
+		Scalar    *config.TypeReference
+		Enum      *Enum
+		Struct    *Struct
+		Interface *Interface
+		Input     *Input
+	}
+)
+
+func (r *Ref) IsList() bool {
+	_, ok := r.GO.(*types.Slice)
+	return ok && r.GQL.Elem != nil
+}
+
+func (r *Ref) IsPtr() bool {
+	_, ok := r.GO.(*types.Pointer)
+	return ok
+}
+
+func (r *Ref) Nullable() bool {
+	return !r.GQL.NonNull
+}
+
+func (r *Ref) IsMap() bool {
+	_, ok := r.GO.(*types.Map)
+	return ok
+}
+
+type builder struct {
+	cfg    *config.Config
+	binder *config.Binder
+	pkg    *types.Package
+	doc    *ast.QueryDocument
+	names  map[string]bool
+	refs   map[string]*Ref
+	data   *Data
+
+	fragments map[string]*Struct
+	enums     map[string]*Enum
+	inputs    map[string]*Input
+}
+
+var typenameDefinition = &ast.FieldDefinition{
+	Name: "__typename",
+	Type: ast.NonNullNamedType("String", nil),
+}
+
+func (b *builder) build(doc *ast.QueryDocument) error {
+	b.doc = doc
+	b.fragments = map[string]*Struct{}
+	b.enums = map[string]*Enum{}
+	b.inputs = map[string]*Input{}
+
+	for _, op := range doc.Operations {
+		if op.Name == "" {
+			return gqlerror.ErrorPosf(op.Position, "operations must be named to generate a client")
+		}
+		if op.Operation == ast.Subscription {
+			return gqlerror.ErrorPosf(op.Position, "%s: subscriptions are not supported", op.Name)
+		}
+
+		o := &Operation{
+			Name:   op.Name,
+			GoName: templates.ToGo(op.Name),
+			Kind:   op.Operation,
+			def:    op,
+		}
+
+		params := map[string]bool{}
+		for _, v := range op.VariableDefinitions {
+			ref, err := b.inputRef(v.Type)
+			if err != nil {
+				return err
+			}
+			param := templates.ToGoPrivate(v.Variable)
+			if reservedParams[param] || params[param] {
+				param += "Arg"
+			}
+			params[param] = true
+			o.Variables = append(o.Variables, &Variable{Name: v.Variable, Param: param, Ref: ref})
+		}
+
+		root := b.cfg.Schema.Query
+		if op.Operation == ast.Mutation {
+			root = b.cfg.Schema.Mutation
+		}
+		response, err := b.object(o.GoName+"Response", o.GoName, op.SelectionSet, root)
+		if err != nil {
+			return err
+		}
+		response.Description = fmt.Sprintf("%s is the response of the %s %s.", response.Name, op.Name, op.Operation)
+		o.Response = response
+		b.data.Structs = append(b.data.Structs, response)
+
+		b.data.Operations = append(b.data.Operations, o)
+	}
+
+	// the selection sets have been completed with __typename while building, the documents are
+	// printed afterwards so they contain it.
+	for _, o := range b.data.Operations {
+		o.Document = b.document(o.def)
+	}
+
+	return nil
+}
+
+// reservedParams are the names used in the body of generated operation functions.
+var reservedParams = map[string]bool{
+	"ctx": true, "doer": true, "variables": true, "data": true, "err": true, "res": true, "uerr": true, "v": true,
+}
+
+// document prints the operation with the fragments it uses.
+func (b *builder) document(op *ast.OperationDefinition) string {
+	doc := &ast.QueryDocument{Operations: ast.OperationList{op}}
+	seen := map[string]bool{}
+	var walk func(set ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				walk(sel.SelectionSet)
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				if seen[sel.Name] {
+					continue
+				}
+				seen[sel.Name] = true
+				fragment := b.doc.Fragments.ForName(sel.Name)
+				doc.Fragments = append(doc.Fragments, fragment)
+				walk(fragment.SelectionSet)
+			}
+		}
+	}
+	walk(op.SelectionSet)
+
+	var sb strings.Builder
+	formatter.NewFormatter(&sb, formatter.WithIndent("  ")).FormatQueryDocument(doc)
+	return sb.String()
+}
+
+func (b *builder) named(name string, underlying types.Type) (*types.Named, error) {
+	if b.names[name] {
+		return nil, fmt.Errorf("%s is generated more than once, rename the operation, fragment or field alias that produces it", name)
+	}
+	b.names[name] = true
+	return types.NewNamed(types.NewTypeName(0, b.pkg, name, nil), underlying, nil), nil
+}
+
+// field is a response key with all the fields selected under it.
+type field struct {
+	key    string
+	fields []*ast.Field
+}
+
+func (f *field) selectionSet() ast.SelectionSet {
+	var set ast.SelectionSet
+	for _, fd := range f.fields {
+		set = append(set, fd.SelectionSet...)
+	}
+	return set
+}
+
+// collect gathers the fields and fragments of set that apply to the type on.
+func (b *builder) collect(set ast.SelectionSet, on *ast.Definition) ([]*field, []*ast.FragmentDefinition) {
+	var fields []*field
+	var spreads []*ast.FragmentDefinition
+	byKey := map[string]*field{}
+	spread := map[string]bool{}
+
+	var visit func(set ast.SelectionSet)
+	visit = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				key := sel.Alias
+				if key == "" {
+					key = sel.Name
+				}
+				f, ok := byKey[key]
+				if !ok {
+					f = &field{key: key}
+					byKey[key] = f
+					fields = append(fields, f)
+				}
+				f.fields = append(f.fields, sel)
+			case *ast.InlineFragment:
+				if b.applies(sel.TypeCondition, on) {
+					visit(sel.SelectionSet)
+				}
+			case *ast.FragmentSpread:
+				fragment := b.doc.Fragments.ForName(sel.Name)
+				if !b.applies(fragment.TypeCondition, on) || spread[fragment.Name] {
+					continue
+				}
+				spread[fragment.Name] = true
+				spreads = append(spreads, fragment)
+				// the fragment struct holds what applies to the fragment's own type, selections
+				// narrowing it to the type at hand are merged in here.
+				condition := b.cfg.Schema.Types[fragment.TypeCondition]
+				for _, inner := range fragment.SelectionSet {
+					if tc, ok := typeCondition(b.doc, inner); ok && !b.applies(tc, condition) && b.applies(tc, on) {
+						visit(ast.SelectionSet{inner})
+					}
+				}
+			}
+		}
+	}
+	visit(set)
+
+	return fields, spreads
+}
+
+func typeCondition(doc *ast.QueryDocument, sel ast.Selection) (string, bool) {
+	switch sel := sel.(type) {
+	case *ast.InlineFragment:
+		return sel.TypeCondition, true
+	case *ast.FragmentSpread:
+		return doc.Fragments.ForName(sel.Name).TypeCondition, true
+	}
+	return "", false
+}
+
+// applies reports whether selections with the type condition tc are part of a selection on def.
+func (b *builder) applies(tc string, def *ast.Definition) bool {
+	if tc == "" || tc == def.Name {
+		return true
+	}
+	if def.Kind == ast.Object {
+		for _, possible := range b.cfg.Schema.GetPossibleTypes(b.cfg.Schema.Types[tc]) {
+			if possible.Name == def.Name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, iface := range def.Interfaces {
+		if iface == tc {
+			return true
+		}
+	}
+	return false
+}
+
+// object builds the struct for a selection set on def, prefix names the types of nested fields.
+func (b *builder) object(name, prefix string, set ast.SelectionSet, def *ast.Definition) (*Struct, error) {
+	named, err := b.named(name, types.NewStruct(nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	s := &Struct{Name: name}
+	s.Ref = b.outputRef(ast.NonNullNamedType(def.Name, nil), named, func(r *Ref) { r.Struct = s })
+
+	fields, spreads := b.collect(set, def)
+	for _, fragment := range spreads {
+		embed, err := b.fragment(fragment)
+		if err != nil {
+			return nil, err
+		}
+		s.Embeds = append(s.Embeds, embed)
+	}
+	for _, f := range fields {
+		field, err := b.field(prefix, f, def)
+		if err != nil {
+			return nil, err
+		}
+		s.Fields = append(s.Fields, field)
+	}
+	return s, nil
+}
+
+func (b *builder) fragment(fragment *ast.FragmentDefinition) (*Struct, error) {
+	if s, ok := b.fragments[fragment.Name]; ok {
+		return s, nil
+	}
+	name := templates.ToGo(fragment.Name)
+	s, err := b.object(name, name, fragment.SelectionSet, b.cfg.Schema.Types[fragment.TypeCondition])
+	if err != nil {
+		return nil, err
+	}
+	s.Description = fmt.Sprintf("%s is the fragment %s on %s.", s.Name, fragment.Name, fragment.TypeCondition)
+	b.fragments[fragment.Name] = s
+	b.data.Structs = append(b.data.Structs, s)
+	return s, nil
+}
+
+func (b *builder) field(prefix string, f *field, parent *ast.Definition) (*Field, error) {
+	first := f.fields[0]
+	goName := templates.ToGo(f.key)
+	if f.key == "__typename" {
+		goName = "Typename"
+	}
+
+	def := first.Definition
+	if first.Name == "__typename" {
+		def = typenameDefinition
+	}
+	if def == nil {
+		return nil, gqlerror.ErrorPosf(first.Position, "unknown field %s on %s", first.Name, parent.Name)
+	}
+
+	typ := def.Type
+	// skipped fields are missing from the response.
+	if first.Directives.ForName("skip") != nil || first.Directives.ForName("include") != nil {
+		typ = &ast.Type{NamedType: typ.NamedType, Elem: typ.Elem, NonNull: false}
+	}
+
+	schemaType := b.cfg.Schema.Types[typ.Name()]
+	var ref *Ref
+	var err error
+	switch schemaType.Kind {
+	case ast.Scalar:
+		ref, err = b.scalarRef(typ, false)
+	case ast.Enum:
+		ref, err = b.enumRef(typ, schemaType, false)
+	case ast.Object:
+		name := prefix + goName
+		var s *Struct
+		s, err = b.object(name, name, f.selectionSet(), schemaType)
+		if err != nil {
+			return nil, err
+		}
+		s.Description = fmt.Sprintf("%s is the selection of %s on %s.", s.Name, f.key, schemaType.Name)
+		b.data.Structs = append(b.data.Structs, s)
+		ref = b.wrap(typ, s.Ref, false)
+	case ast.Interface, ast.Union:
+		ref, err = b.abstract(prefix+goName, f, typ, schemaType)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", schemaType.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Field{GoName: goName, Key: f.key, Ref: ref}, nil
+}
+
+func (b *builder) abstract(name string, f *field, typ *ast.Type, def *ast.Definition) (*Ref, error) {
+	// implementations are told apart by __typename, make sure it is selected.
+	first := f.fields[0]
+	hasTypename := false
+	for _, sel := range first.SelectionSet {
+		if fd, ok := sel.(*ast.Field); ok && fd.Name == "__typename" && (fd.Alias == "" || fd.Alias == "__typename") {
+			hasTypename = true
+		}
+	}
+	if !hasTypename {
+		first.SelectionSet = append(first.SelectionSet, &ast.Field{
+			Alias:      "__typename",
+			Name:       "__typename",
+			Definition: typenameDefinition,
+			Position:   first.Position,
+		})
+	}
+	set := f.selectionSet()
+
+	named, err := b.named(name, types.NewInterfaceType(nil, nil).Complete())
+	if err != nil {
+		return nil, err
+	}
+	iface := &Interface{
+		Name:        name,
+		Description: fmt.Sprintf("%s is the selection of %s on %s, one of %s.", name, f.key, def.Name, b.possibleTypeNames(def)),
+	}
+
+	common, _ := b.collect(set, def)
+	for _, c := range common {
+		if !b.isLeaf(c.fields[0]) {
+			continue
+		}
+		getter, err := b.field(name, c, def)
+		if err != nil {
+			return nil, err
+		}
+		iface.Getters = append(iface.Getters, getter)
+	}
+
+	for _, possible := range b.possibleTypes(def) {
+		impl, err := b.object(name+templates.ToGo(possible.Name), name+templates.ToGo(possible.Name), set, possible)
+		if err != nil {
+			return nil, err
+		}
+		impl.TypeName = possible.Name
+		impl.Description = fmt.Sprintf("%s is the selection of %s when it is a %s.", impl.Name, f.key, possible.Name)
+		impl.Implements = append(impl.Implements, iface)
+		iface.Implementations = append(iface.Implementations, impl)
+		b.data.Structs = append(b.data.Structs, impl)
+	}
+	b.data.Interfaces = append(b.data.Interfaces, iface)
+
+	base := &Ref{
+		GO:        named,
+		GQL:       ast.NonNullNamedType(def.Name, nil),
+		Interface: iface,
+	}
+	return b.wrap(typ, b.register(base, false), false), nil
+}
+
+func (b *builder) isLeaf(f *ast.Field) bool {
+	if f.Name == "__typename" {
+		return true
+	}
+	return f.Definition != nil && b.cfg.Schema.Types[f.Definition.Type.Name()].IsLeafType()
+}
+
+func (b *builder) possibleTypes(def *ast.Definition) []*ast.Definition {
+	possible := append([]*ast.Definition{}, b.cfg.Schema.GetPossibleTypes(def)...)
+	sort.Slice(possible, func(i, j int) bool { return possible[i].Name < possible[j].Name })
+	return possible
+}
+
+func (b *builder) possibleTypeNames(def *ast.Definition) string {
+	var names []string
+	for _, p := range b.possibleTypes(def) {
+		names = append(names, p.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (b *builder) outputRef(gql *ast.Type, named types.Type, set func(r *Ref)) *Ref {
+	r := &Ref{GO: named, GQL: gql}
+	set(r)
+	return b.register(r, false)
+}
+
+// register dedupes refs by their Go and GraphQL type and names their (un)marshal function.
+func (b *builder) register(r *Ref, input bool) *Ref {
+	key := gqlIdentifier(r.GQL) + "2" + templates.TypeIdentifier(r.GO)
+	if input {
+		key = "marshal" + key
+	} else {
+		key = "unmarshal" + key
+	}
+	if existing, ok := b.refs[key]; ok {
+		return existing
+	}
+	r.Func = key
+	b.refs[key] = r
+	if input {
+		b.data.InputRefs = append(b.data.InputRefs, r)
+	} else {
+		b.data.Refs = append(b.data.Refs, r)
+	}
+	return r
+}
+
+var gqlIdentifierReplacer = strings.NewReplacer("[", "L", "]", "", "!", "N")
+
+func gqlIdentifier(t *ast.Type) string {
+	return gqlIdentifierReplacer.Replace(t.String())
+}
+
+// wrap applies the list and null modifiers of typ around the non-null named base.
+func (b *builder) wrap(typ *ast.Type, base *Ref, input bool) *Ref {
+	if typ.Elem != nil {
+		elem := b.wrap(typ.Elem, base, input)
+		return b.register(&Ref{GO: types.NewSlice(elem.GO), GQL: typ, Elem: elem}, input)
+	}
+	if typ.NonNull {
+		return base
+	}
+	if config.IsNilable(base.GO) {
+		r := *base
+		r.GQL = typ
+		return b.register(&r, input)
+	}
+	return b.register(&Ref{GO: types.NewPointer(base.GO), GQL: typ, Elem: base}, input)
+}
+
+func (b *builder) scalarRef(typ *ast.Type, input bool) (*Ref, error) {
+	base := ast.NonNullNamedType(typ.Name(), nil)
+	scalar, err := b.binder.TypeReference(base, nil)
+	if err != nil {
+		return nil, fmt.Errorf("scalar %s: %w", typ.Name(), err)
+	}
+	if scalar.Unmarshaler == nil && !scalar.IsMarshaler && !isMap(scalar.GO) && !isAny(scalar.GO) {
+		return nil, fmt.Errorf("scalar %s: %s can not be marshalled", typ.Name(), scalar.GO)
+	}
+	return b.wrap(typ, b.register(&Ref{GO: scalar.GO, GQL: base, Scalar: scalar}, input), input), nil
+}
+
+func isMap(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Map)
+	return ok
+}
+
+func isAny(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Interface)
+	return ok
+}
+
+func (b *builder) enumRef(typ *ast.Type, def *ast.Definition, input bool) (*Ref, error) {
+	name := templates.ToGo(def.Name)
+	e, ok := b.enums[def.Name]
+	if !ok {
+		named, err := b.named(name, types.Typ[types.String])
+		if err != nil {
+			return nil, err
+		}
+		e = &Enum{Name: name, Description: def.Description, typ: named}
+		for _, v := range def.EnumValues {
+			e.Values = append(e.Values, &EnumValue{Name: v.Name, GoName: templates.ToGo(def.Name + "_" + v.Name)})
+		}
+		b.enums[def.Name] = e
+		b.data.Enums = append(b.data.Enums, e)
+	}
+	base := b.register(&Ref{GO: e.typ, GQL: ast.NonNullNamedType(def.Name, nil), Enum: e}, input)
+	return b.wrap(typ, base, input), nil
+}
+
+func (b *builder) inputRef(typ *ast.Type) (*Ref, error) {
+	def := b.cfg.Schema.Types[typ.Name()]
+	switch def.Kind {
+	case ast.Scalar:
+		return b.scalarRef(typ, true)
+	case ast.Enum:
+		return b.enumRef(typ, def, true)
+	case ast.InputObject:
+	default:
+		return nil, fmt.Errorf("%s can not be used as a variable", def.Name)
+	}
+
+	input, ok := b.inputs[def.Name]
+	if !ok {
+		name := templates.ToGo(def.Name)
+		named, err := b.named(name, types.NewStruct(nil, nil))
+		if err != nil {
+			return nil, err
+		}
+		input = &Input{Name: name, Description: def.Description, typ: named}
+		// registered before the fields are resolved, inputs may refer to themselves.
+		b.inputs[def.Name] = input
+		b.data.Inputs = append(b.data.Inputs, input)
+		for _, f := range def.Fields {
+			ref, err := b.inputRef(f.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", def.Name, f.Name, err)
+			}
+			input.Fields = append(input.Fields, &InputField{Name: f.Name, GoName: templates.ToGo(f.Name), Ref: ref})
+		}
+	}
+	base := b.register(&Ref{GO: input.typ, GQL: ast.NonNullNamedType(def.Name, nil), Input: input}, true)
+	return b.wrap(typ, base, true), nil
+}
@@ -0,0 +1,219 @@
+package clientgen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/client/clientgen/out"
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func TestGenerate(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	require.NoError(t, Generate(cfg))
+	require.NoError(t, goBuild(t, "./out/"))
+
+	generated, err := os.ReadFile("./out/generated.go")
+	require.NoError(t, err)
+
+	t.Run("typename is added to abstract selections", func(t *testing.T) {
+		assert.Contains(t, out.SearchDocument, "__typename")
+		assert.NotContains(t, out.GetUserDocument, "__typename")
+	})
+
+	t.Run("documents contain the fragments they use", func(t *testing.T) {
+		assert.Contains(t, out.GetUserDocument, "fragment UserFields on User")
+		assert.NotContains(t, out.GetUserDocument, "PostSummary")
+	})
+
+	t.Run("no pointer pointers", func(t *testing.T) {
+		assert.NotContains(t, string(generated), "**")
+	})
+}
+
+func TestGenerateErrors(t *testing.T) {
+	load := func(t *testing.T, operations string) *config.Config {
+		t.Helper()
+		cfg, err := config.LoadConfig("testdata/gqlgen.yml")
+		require.NoError(t, err)
+		require.NoError(t, cfg.LoadSchema())
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(dir+"/operations.graphql", []byte(operations), 0o644))
+		cfg.Client.Operations = config.StringList{dir + "/*.graphql"}
+		return cfg
+	}
+
+	t.Run("invalid operations", func(t *testing.T) {
+		err := Generate(load(t, `query Q { nope }`))
+		require.ErrorContains(t, err, `Cannot query field "nope" on type "Query"`)
+	})
+
+	t.Run("anonymous operations", func(t *testing.T) {
+		err := Generate(load(t, `{ user(id: "1") { id } }`))
+		require.ErrorContains(t, err, "operations must be named")
+	})
+
+	t.Run("no operation files", func(t *testing.T) {
+		cfg := load(t, `query Q { user(id: "1") { id } }`)
+		cfg.Client.Operations = nil
+		require.ErrorContains(t, Generate(cfg), "no operation files found")
+	})
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// respond returns a handler that records the request and answers with response.
+func respond(t *testing.T, got *request, response string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	})
+}
+
+func TestGeneratedClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fragments and scalars", func(t *testing.T) {
+		var got request
+		c := client.New(respond(t, &got, `{"data":{"user":{
+			"id":"1","name":"Ada","role":"ADMIN","balance":1250,"email":null,
+			"joinedAt":"2020-01-02T03:04:05Z","friends":[{"id":"2","name":"Grace"}]
+		}}}`))
+
+		first := 10
+		res, err := out.GetUser(ctx, c, "1", &first)
+		require.NoError(t, err)
+
+		assert.Equal(t, "GetUser", got.OperationName)
+		assert.Equal(t, out.GetUserDocument, got.Query)
+		assert.Equal(t, map[string]any{"id": "1", "first": float64(10)}, got.Variables)
+
+		require.NotNil(t, res.User)
+		assert.Equal(t, "Ada", res.User.Name)
+		assert.Equal(t, out.RoleAdmin, res.User.Role)
+		assert.Equal(t, out.Cents(1250), res.User.Balance)
+		assert.Nil(t, res.User.Email)
+		assert.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), res.User.JoinedAt)
+		assert.Equal(t, []out.GetUserUserFriends{{ID: "2", Name: "Grace"}}, res.User.Friends)
+	})
+
+	t.Run("nil variables are left out", func(t *testing.T) {
+		var got request
+		c := client.New(respond(t, &got, `{"data":{"user":null}}`))
+
+		res, err := out.GetUser(ctx, c, "1", nil)
+		require.NoError(t, err)
+		assert.Nil(t, res.User)
+		assert.Equal(t, map[string]any{"id": "1"}, got.Variables)
+	})
+
+	t.Run("unions", func(t *testing.T) {
+		var got request
+		c := client.New(respond(t, &got, `{"data":{"search":[
+			{"__typename":"User","id":"1","name":"Ada"},
+			{"__typename":"Post","id":"2","title":"Notes","author":{"name":"Ada"}}
+		]}}`))
+
+		res, err := out.Search(ctx, c, "a", []out.Kind{out.KindUser, out.KindPost})
+		require.NoError(t, err)
+		assert.Equal(t, []any{"USER", "POST"}, got.Variables["kinds"])
+
+		require.Len(t, res.Search, 2)
+		user, ok := res.Search[0].(out.SearchSearchUser)
+		require.True(t, ok)
+		assert.Equal(t, "Ada", user.Name)
+		post, ok := res.Search[1].(out.SearchSearchPost)
+		require.True(t, ok)
+		assert.Equal(t, "Notes", post.Title)
+		assert.Equal(t, "Ada", post.Author.Name)
+		assert.Equal(t, "Post", post.GetTypename())
+	})
+
+	t.Run("interfaces", func(t *testing.T) {
+		var got request
+		c := client.New(respond(t, &got, `{"data":{"node":{"__typename":"Post","id":"2","title":"Notes","tags":["a"]}}}`))
+
+		res, err := out.GetNode(ctx, c, "2")
+		require.NoError(t, err)
+		assert.Equal(t, "2", res.Node.GetID())
+		post, ok := res.Node.(out.GetNodeNodePost)
+		require.True(t, ok)
+		assert.Equal(t, out.PostSummary{Title: "Notes", Tags: []string{"a"}}, post.PostSummary)
+	})
+
+	t.Run("inputs", func(t *testing.T) {
+		var got request
+		c := client.New(respond(t, &got, `{"data":{"createPost":{"id":"3","title":"Hi","tags":null,"price":100}}}`))
+
+		price := out.Cents(100)
+		featured := true
+		res, err := out.CreatePost(ctx, c, out.PostInput{
+			Title: "Hi",
+			Price: &price,
+			Meta:  &out.PostMetaInput{Featured: &featured},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"title": "Hi",
+			"price": float64(100),
+			"meta":  map[string]any{"featured": true},
+		}, got.Variables["input"])
+		assert.Equal(t, out.Cents(100), *res.CreatePost.Price)
+		assert.Nil(t, res.CreatePost.Tags)
+	})
+
+	t.Run("errors are returned with partial data", func(t *testing.T) {
+		var got request
+		c := client.New(respond(t, &got, `{"errors":[{"message":"not allowed","path":["user","email"]}],"data":{"user":null}}`))
+
+		res, err := out.GetUser(ctx, c, "1", nil)
+		require.NotNil(t, res)
+		assert.Nil(t, res.User)
+
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, "not allowed", errs[0].Message)
+	})
+
+	t.Run("remote endpoint", func(t *testing.T) {
+		var got request
+		srv := httptest.NewServer(respond(t, &got, `{"data":{"node":{"__typename":"User","id":"1"}}}`))
+		defer srv.Close()
+
+		res, err := out.GetNode(ctx, client.NewRemote(srv.URL), "1")
+		require.NoError(t, err)
+		assert.IsType(t, out.GetNodeNodeUser{}, res.Node)
+	})
+}
+
+func goBuild(t *testing.T, path string) error {
+	t.Helper()
+	cmd := exec.Command("go", "build", path)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.New(string(out))
+	}
+
+	return nil
+}
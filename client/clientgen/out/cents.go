@@ -0,0 +1,25 @@
+package out
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Cents is a custom scalar bound in the models section of the test config.
+type Cents int64
+
+func (c *Cents) UnmarshalGQL(v any) error {
+	i, err := graphql.UnmarshalInt64(v)
+	if err != nil {
+		return fmt.Errorf("cents: %w", err)
+	}
+	*c = Cents(i)
+	return nil
+}
+
+func (c Cents) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.FormatInt(int64(c), 10))
+}
@@ -0,0 +1,993 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// GetUserDocument is the document sent by GetUser.
+const GetUserDocument = `query GetUser ($id: ID!, $first: Int) {
+  user(id: $id) {
+    ... UserFields
+    email
+    joinedAt
+    friends(first: $first) {
+      id
+      name
+    }
+  }
+}
+fragment UserFields on User {
+  id
+  name
+  role
+  balance
+}
+`
+
+// GetUser sends the GetUser query. When the server reports errors they are
+// returned as a gqlerror.List together with the partial response.
+func GetUser(ctx context.Context, doer client.Doer, id string, first *int) (*GetUserResponse, error) {
+	variables := map[string]any{}
+	if v, err := marshalIDN2string(ctx, id); err != nil {
+		return nil, fmt.Errorf("id: %w", err)
+	} else if v != nil {
+		variables["id"] = v
+	}
+	if v, err := marshalInt2ᚖint(ctx, first); err != nil {
+		return nil, fmt.Errorf("first: %w", err)
+	} else if v != nil {
+		variables["first"] = v
+	}
+
+	data, err := client.Execute(ctx, doer, GetUserDocument, "GetUser", variables)
+	if data == nil {
+		return nil, err
+	}
+	res, uerr := unmarshalQueryN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserResponse(ctx, data)
+	if uerr != nil {
+		return nil, uerr
+	}
+	return &res, err
+}
+
+// SearchDocument is the document sent by Search.
+const SearchDocument = `query Search ($text: String!, $kinds: [Kind!]) {
+  search(text: $text, kinds: $kinds) {
+    ... on User {
+      id
+      name
+    }
+    ... on Post {
+      id
+      title
+      author {
+        name
+      }
+    }
+    __typename
+  }
+}
+`
+
+// Search sends the Search query. When the server reports errors they are
+// returned as a gqlerror.List together with the partial response.
+func Search(ctx context.Context, doer client.Doer, text string, kinds []Kind) (*SearchResponse, error) {
+	variables := map[string]any{}
+	if v, err := marshalStringN2string(ctx, text); err != nil {
+		return nil, fmt.Errorf("text: %w", err)
+	} else if v != nil {
+		variables["text"] = v
+	}
+	if v, err := marshalLKindN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐKind(ctx, kinds); err != nil {
+		return nil, fmt.Errorf("kinds: %w", err)
+	} else if v != nil {
+		variables["kinds"] = v
+	}
+
+	data, err := client.Execute(ctx, doer, SearchDocument, "Search", variables)
+	if data == nil {
+		return nil, err
+	}
+	res, uerr := unmarshalQueryN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchResponse(ctx, data)
+	if uerr != nil {
+		return nil, uerr
+	}
+	return &res, err
+}
+
+// GetNodeDocument is the document sent by GetNode.
+const GetNodeDocument = `query GetNode ($id: ID!) {
+  node(id: $id) {
+    id
+    ... PostSummary
+    __typename
+  }
+}
+fragment PostSummary on Post {
+  title
+  tags
+}
+`
+
+// GetNode sends the GetNode query. When the server reports errors they are
+// returned as a gqlerror.List together with the partial response.
+func GetNode(ctx context.Context, doer client.Doer, id string) (*GetNodeResponse, error) {
+	variables := map[string]any{}
+	if v, err := marshalIDN2string(ctx, id); err != nil {
+		return nil, fmt.Errorf("id: %w", err)
+	} else if v != nil {
+		variables["id"] = v
+	}
+
+	data, err := client.Execute(ctx, doer, GetNodeDocument, "GetNode", variables)
+	if data == nil {
+		return nil, err
+	}
+	res, uerr := unmarshalQueryN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeResponse(ctx, data)
+	if uerr != nil {
+		return nil, uerr
+	}
+	return &res, err
+}
+
+// CreatePostDocument is the document sent by CreatePost.
+const CreatePostDocument = `mutation CreatePost ($input: PostInput!) {
+  createPost(input: $input) {
+    id
+    title
+    tags
+    price
+  }
+}
+`
+
+// CreatePost sends the CreatePost mutation. When the server reports errors they are
+// returned as a gqlerror.List together with the partial response.
+func CreatePost(ctx context.Context, doer client.Doer, input PostInput) (*CreatePostResponse, error) {
+	variables := map[string]any{}
+	if v, err := marshalPostInputN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostInput(ctx, input); err != nil {
+		return nil, fmt.Errorf("input: %w", err)
+	} else if v != nil {
+		variables["input"] = v
+	}
+
+	data, err := client.Execute(ctx, doer, CreatePostDocument, "CreatePost", variables)
+	if data == nil {
+		return nil, err
+	}
+	res, uerr := unmarshalMutationN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCreatePostResponse(ctx, data)
+	if uerr != nil {
+		return nil, uerr
+	}
+	return &res, err
+}
+
+// UserFields is the fragment UserFields on User.
+type UserFields struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Role    Role   `json:"role"`
+	Balance Cents  `json:"balance"`
+}
+
+// GetUserUserFriends is the selection of friends on User.
+type GetUserUserFriends struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetUserUser is the selection of user on User.
+type GetUserUser struct {
+	UserFields
+	Email    *string              `json:"email"`
+	JoinedAt time.Time            `json:"joinedAt"`
+	Friends  []GetUserUserFriends `json:"friends"`
+}
+
+// GetUserResponse is the response of the GetUser query.
+type GetUserResponse struct {
+	User *GetUserUser `json:"user"`
+}
+
+// SearchSearchPostAuthor is the selection of author on User.
+type SearchSearchPostAuthor struct {
+	Name string `json:"name"`
+}
+
+// SearchSearchPost is the selection of search when it is a Post.
+type SearchSearchPost struct {
+	ID       string                 `json:"id"`
+	Title    string                 `json:"title"`
+	Author   SearchSearchPostAuthor `json:"author"`
+	Typename string                 `json:"__typename"`
+}
+
+func (SearchSearchPost) isSearchSearch()       {}
+func (v SearchSearchPost) GetTypename() string { return v.Typename }
+
+// SearchSearchUser is the selection of search when it is a User.
+type SearchSearchUser struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Typename string `json:"__typename"`
+}
+
+func (SearchSearchUser) isSearchSearch()       {}
+func (v SearchSearchUser) GetTypename() string { return v.Typename }
+
+// SearchResponse is the response of the Search query.
+type SearchResponse struct {
+	Search []SearchSearch `json:"search"`
+}
+
+// PostSummary is the fragment PostSummary on Post.
+type PostSummary struct {
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
+}
+
+// GetNodeNodePost is the selection of node when it is a Post.
+type GetNodeNodePost struct {
+	PostSummary
+	ID       string `json:"id"`
+	Typename string `json:"__typename"`
+}
+
+func (GetNodeNodePost) isGetNodeNode()        {}
+func (v GetNodeNodePost) GetID() string       { return v.ID }
+func (v GetNodeNodePost) GetTypename() string { return v.Typename }
+
+// GetNodeNodeUser is the selection of node when it is a User.
+type GetNodeNodeUser struct {
+	ID       string `json:"id"`
+	Typename string `json:"__typename"`
+}
+
+func (GetNodeNodeUser) isGetNodeNode()        {}
+func (v GetNodeNodeUser) GetID() string       { return v.ID }
+func (v GetNodeNodeUser) GetTypename() string { return v.Typename }
+
+// GetNodeResponse is the response of the GetNode query.
+type GetNodeResponse struct {
+	Node GetNodeNode `json:"node"`
+}
+
+// CreatePostCreatePost is the selection of createPost on Post.
+type CreatePostCreatePost struct {
+	ID    string   `json:"id"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
+	Price *Cents   `json:"price"`
+}
+
+// CreatePostResponse is the response of the CreatePost mutation.
+type CreatePostResponse struct {
+	CreatePost CreatePostCreatePost `json:"createPost"`
+}
+
+// SearchSearch is the selection of search on SearchResult, one of Post, User.
+type SearchSearch interface {
+	isSearchSearch()
+	GetTypename() string
+}
+
+// GetNodeNode is the selection of node on Node, one of Post, User.
+type GetNodeNode interface {
+	isGetNodeNode()
+	GetID() string
+	GetTypename() string
+}
+
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleMember Role = "MEMBER"
+)
+
+type Kind string
+
+const (
+	KindUser Kind = "USER"
+	KindPost Kind = "POST"
+)
+
+type PostInput struct {
+	Title     string         `json:"title"`
+	Tags      []string       `json:"tags"`
+	Price     *Cents         `json:"price"`
+	PublishAt *time.Time     `json:"publishAt"`
+	Meta      *PostMetaInput `json:"meta"`
+}
+
+type PostMetaInput struct {
+	Featured *bool       `json:"featured"`
+	Related  []PostInput `json:"related"`
+}
+
+func unmarshalQueryN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserResponse(ctx context.Context, v any) (GetUserResponse, error) {
+	if v == nil {
+		var zero GetUserResponse
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return GetUserResponse{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res GetUserResponse
+	var err error
+	if res.User, err = unmarshalUser2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUser(ctx, m["user"]); err != nil {
+		return res, fmt.Errorf("user: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUser(ctx context.Context, v any) (GetUserUser, error) {
+	if v == nil {
+		var zero GetUserUser
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return GetUserUser{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res GetUserUser
+	var err error
+	if res.UserFields, err = unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐUserFields(ctx, m); err != nil {
+		return res, err
+	}
+	if res.Email, err = unmarshalString2ᚖstring(ctx, m["email"]); err != nil {
+		return res, fmt.Errorf("email: %w", err)
+	}
+	if res.JoinedAt, err = unmarshalTimeN2timeᚐTime(ctx, m["joinedAt"]); err != nil {
+		return res, fmt.Errorf("joinedAt: %w", err)
+	}
+	if res.Friends, err = unmarshalLUserNN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUserFriends(ctx, m["friends"]); err != nil {
+		return res, fmt.Errorf("friends: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐUserFields(ctx context.Context, v any) (UserFields, error) {
+	if v == nil {
+		var zero UserFields
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return UserFields{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res UserFields
+	var err error
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Name, err = unmarshalStringN2string(ctx, m["name"]); err != nil {
+		return res, fmt.Errorf("name: %w", err)
+	}
+	if res.Role, err = unmarshalRoleN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐRole(ctx, m["role"]); err != nil {
+		return res, fmt.Errorf("role: %w", err)
+	}
+	if res.Balance, err = unmarshalCentsN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx, m["balance"]); err != nil {
+		return res, fmt.Errorf("balance: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalIDN2string(ctx context.Context, v any) (string, error) {
+	if v == nil {
+		var zero string
+		return zero, errors.New("unexpected null")
+	}
+	return graphql.UnmarshalID(v)
+}
+
+func unmarshalStringN2string(ctx context.Context, v any) (string, error) {
+	if v == nil {
+		var zero string
+		return zero, errors.New("unexpected null")
+	}
+	return graphql.UnmarshalString(v)
+}
+
+func unmarshalRoleN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐRole(ctx context.Context, v any) (Role, error) {
+	if v == nil {
+		var zero Role
+		return zero, errors.New("unexpected null")
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string for Role, got %T", v)
+	}
+	return Role(s), nil
+}
+
+func unmarshalCentsN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx context.Context, v any) (Cents, error) {
+	if v == nil {
+		var zero Cents
+		return zero, errors.New("unexpected null")
+	}
+	var res Cents
+	err := res.UnmarshalGQL(v)
+	return res, err
+}
+
+func unmarshalString2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := unmarshalStringN2string(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func unmarshalTimeN2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	if v == nil {
+		var zero time.Time
+		return zero, errors.New("unexpected null")
+	}
+	return graphql.UnmarshalTime(v)
+}
+
+func unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUserFriends(ctx context.Context, v any) (GetUserUserFriends, error) {
+	if v == nil {
+		var zero GetUserUserFriends
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return GetUserUserFriends{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res GetUserUserFriends
+	var err error
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Name, err = unmarshalStringN2string(ctx, m["name"]); err != nil {
+		return res, fmt.Errorf("name: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalLUserNN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUserFriends(ctx context.Context, v any) ([]GetUserUserFriends, error) {
+	if v == nil {
+		return nil, errors.New("unexpected null")
+	}
+	vSlice, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list, got %T", v)
+	}
+	res := make([]GetUserUserFriends, len(vSlice))
+	for i := range vSlice {
+		var err error
+		res[i], err = unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUserFriends(ctx, vSlice[i])
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+func unmarshalUser2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUser(ctx context.Context, v any) (*GetUserUser, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetUserUser(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func unmarshalQueryN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchResponse(ctx context.Context, v any) (SearchResponse, error) {
+	if v == nil {
+		var zero SearchResponse
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return SearchResponse{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res SearchResponse
+	var err error
+	if res.Search, err = unmarshalLSearchResultNN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearch(ctx, m["search"]); err != nil {
+		return res, fmt.Errorf("search: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearchPost(ctx context.Context, v any) (SearchSearchPost, error) {
+	if v == nil {
+		var zero SearchSearchPost
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return SearchSearchPost{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res SearchSearchPost
+	var err error
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Title, err = unmarshalStringN2string(ctx, m["title"]); err != nil {
+		return res, fmt.Errorf("title: %w", err)
+	}
+	if res.Author, err = unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearchPostAuthor(ctx, m["author"]); err != nil {
+		return res, fmt.Errorf("author: %w", err)
+	}
+	if res.Typename, err = unmarshalStringN2string(ctx, m["__typename"]); err != nil {
+		return res, fmt.Errorf("__typename: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearchPostAuthor(ctx context.Context, v any) (SearchSearchPostAuthor, error) {
+	if v == nil {
+		var zero SearchSearchPostAuthor
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return SearchSearchPostAuthor{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res SearchSearchPostAuthor
+	var err error
+	if res.Name, err = unmarshalStringN2string(ctx, m["name"]); err != nil {
+		return res, fmt.Errorf("name: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearchUser(ctx context.Context, v any) (SearchSearchUser, error) {
+	if v == nil {
+		var zero SearchSearchUser
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return SearchSearchUser{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res SearchSearchUser
+	var err error
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Name, err = unmarshalStringN2string(ctx, m["name"]); err != nil {
+		return res, fmt.Errorf("name: %w", err)
+	}
+	if res.Typename, err = unmarshalStringN2string(ctx, m["__typename"]); err != nil {
+		return res, fmt.Errorf("__typename: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalSearchResultN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearch(ctx context.Context, v any) (SearchSearch, error) {
+	if v == nil {
+		var zero SearchSearch
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %T", v)
+	}
+	switch m["__typename"] {
+	case "Post":
+		res, err := unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearchPost(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case "User":
+		res, err := unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearchUser(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unexpected __typename %v for SearchSearch", m["__typename"])
+	}
+}
+
+func unmarshalLSearchResultNN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearch(ctx context.Context, v any) ([]SearchSearch, error) {
+	if v == nil {
+		return nil, errors.New("unexpected null")
+	}
+	vSlice, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list, got %T", v)
+	}
+	res := make([]SearchSearch, len(vSlice))
+	for i := range vSlice {
+		var err error
+		res[i], err = unmarshalSearchResultN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐSearchSearch(ctx, vSlice[i])
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+func unmarshalQueryN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeResponse(ctx context.Context, v any) (GetNodeResponse, error) {
+	if v == nil {
+		var zero GetNodeResponse
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return GetNodeResponse{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res GetNodeResponse
+	var err error
+	if res.Node, err = unmarshalNode2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNode(ctx, m["node"]); err != nil {
+		return res, fmt.Errorf("node: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNodePost(ctx context.Context, v any) (GetNodeNodePost, error) {
+	if v == nil {
+		var zero GetNodeNodePost
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return GetNodeNodePost{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res GetNodeNodePost
+	var err error
+	if res.PostSummary, err = unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostSummary(ctx, m); err != nil {
+		return res, err
+	}
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Typename, err = unmarshalStringN2string(ctx, m["__typename"]); err != nil {
+		return res, fmt.Errorf("__typename: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostSummary(ctx context.Context, v any) (PostSummary, error) {
+	if v == nil {
+		var zero PostSummary
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return PostSummary{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res PostSummary
+	var err error
+	if res.Title, err = unmarshalStringN2string(ctx, m["title"]); err != nil {
+		return res, fmt.Errorf("title: %w", err)
+	}
+	if res.Tags, err = unmarshalLStringN2ᚕstring(ctx, m["tags"]); err != nil {
+		return res, fmt.Errorf("tags: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalLStringN2ᚕstring(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	vSlice, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list, got %T", v)
+	}
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		var err error
+		res[i], err = unmarshalStringN2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+func unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNodeUser(ctx context.Context, v any) (GetNodeNodeUser, error) {
+	if v == nil {
+		var zero GetNodeNodeUser
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return GetNodeNodeUser{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res GetNodeNodeUser
+	var err error
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Typename, err = unmarshalStringN2string(ctx, m["__typename"]); err != nil {
+		return res, fmt.Errorf("__typename: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalNodeN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNode(ctx context.Context, v any) (GetNodeNode, error) {
+	if v == nil {
+		var zero GetNodeNode
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %T", v)
+	}
+	switch m["__typename"] {
+	case "Post":
+		res, err := unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNodePost(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case "User":
+		res, err := unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNodeUser(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unexpected __typename %v for GetNodeNode", m["__typename"])
+	}
+}
+
+func unmarshalNode2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNode(ctx context.Context, v any) (GetNodeNode, error) {
+	if v == nil {
+		var zero GetNodeNode
+		return zero, nil
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %T", v)
+	}
+	switch m["__typename"] {
+	case "Post":
+		res, err := unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNodePost(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case "User":
+		res, err := unmarshalUserN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐGetNodeNodeUser(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unexpected __typename %v for GetNodeNode", m["__typename"])
+	}
+}
+
+func unmarshalMutationN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCreatePostResponse(ctx context.Context, v any) (CreatePostResponse, error) {
+	if v == nil {
+		var zero CreatePostResponse
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return CreatePostResponse{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res CreatePostResponse
+	var err error
+	if res.CreatePost, err = unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCreatePostCreatePost(ctx, m["createPost"]); err != nil {
+		return res, fmt.Errorf("createPost: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalPostN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCreatePostCreatePost(ctx context.Context, v any) (CreatePostCreatePost, error) {
+	if v == nil {
+		var zero CreatePostCreatePost
+		return zero, errors.New("unexpected null")
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return CreatePostCreatePost{}, fmt.Errorf("expected an object, got %T", v)
+	}
+	var res CreatePostCreatePost
+	var err error
+	if res.ID, err = unmarshalIDN2string(ctx, m["id"]); err != nil {
+		return res, fmt.Errorf("id: %w", err)
+	}
+	if res.Title, err = unmarshalStringN2string(ctx, m["title"]); err != nil {
+		return res, fmt.Errorf("title: %w", err)
+	}
+	if res.Tags, err = unmarshalLStringN2ᚕstring(ctx, m["tags"]); err != nil {
+		return res, fmt.Errorf("tags: %w", err)
+	}
+	if res.Price, err = unmarshalCents2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx, m["price"]); err != nil {
+		return res, fmt.Errorf("price: %w", err)
+	}
+	return res, nil
+}
+
+func unmarshalCents2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx context.Context, v any) (*Cents, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := unmarshalCentsN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func marshalIDN2string(ctx context.Context, v string) (any, error) {
+	var buf bytes.Buffer
+	graphql.MarshalID(v).MarshalGQL(&buf)
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func marshalIntN2int(ctx context.Context, v int) (any, error) {
+	var buf bytes.Buffer
+	graphql.MarshalInt(v).MarshalGQL(&buf)
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func marshalInt2ᚖint(ctx context.Context, v *int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return marshalIntN2int(ctx, *v)
+}
+
+func marshalStringN2string(ctx context.Context, v string) (any, error) {
+	var buf bytes.Buffer
+	graphql.MarshalString(v).MarshalGQL(&buf)
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func marshalKindN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐKind(ctx context.Context, v Kind) (any, error) {
+	return string(v), nil
+}
+
+func marshalLKindN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐKind(ctx context.Context, v []Kind) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res := make([]any, len(v))
+	for i := range v {
+		var err error
+		res[i], err = marshalKindN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐKind(ctx, v[i])
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+func marshalLStringN2ᚕstring(ctx context.Context, v []string) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res := make([]any, len(v))
+	for i := range v {
+		var err error
+		res[i], err = marshalStringN2string(ctx, v[i])
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+func marshalCentsN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx context.Context, v Cents) (any, error) {
+	var buf bytes.Buffer
+	v.MarshalGQL(&buf)
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func marshalCents2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx context.Context, v *Cents) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return marshalCentsN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx, *v)
+}
+
+func marshalTimeN2timeᚐTime(ctx context.Context, v time.Time) (any, error) {
+	var buf bytes.Buffer
+	graphql.MarshalTime(v).MarshalGQL(&buf)
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func marshalTime2ᚖtimeᚐTime(ctx context.Context, v *time.Time) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return marshalTimeN2timeᚐTime(ctx, *v)
+}
+
+func marshalBooleanN2bool(ctx context.Context, v bool) (any, error) {
+	var buf bytes.Buffer
+	graphql.MarshalBoolean(v).MarshalGQL(&buf)
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func marshalBoolean2ᚖbool(ctx context.Context, v *bool) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return marshalBooleanN2bool(ctx, *v)
+}
+
+func marshalPostInputN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostInput(ctx context.Context, v PostInput) (any, error) {
+	res := map[string]any{}
+	if f, err := marshalStringN2string(ctx, v.Title); err != nil {
+		return nil, fmt.Errorf("title: %w", err)
+	} else if f != nil {
+		res["title"] = f
+	}
+	if f, err := marshalLStringN2ᚕstring(ctx, v.Tags); err != nil {
+		return nil, fmt.Errorf("tags: %w", err)
+	} else if f != nil {
+		res["tags"] = f
+	}
+	if f, err := marshalCents2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐCents(ctx, v.Price); err != nil {
+		return nil, fmt.Errorf("price: %w", err)
+	} else if f != nil {
+		res["price"] = f
+	}
+	if f, err := marshalTime2ᚖtimeᚐTime(ctx, v.PublishAt); err != nil {
+		return nil, fmt.Errorf("publishAt: %w", err)
+	} else if f != nil {
+		res["publishAt"] = f
+	}
+	if f, err := marshalPostMetaInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostMetaInput(ctx, v.Meta); err != nil {
+		return nil, fmt.Errorf("meta: %w", err)
+	} else if f != nil {
+		res["meta"] = f
+	}
+	return res, nil
+}
+
+func marshalLPostInputN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostInput(ctx context.Context, v []PostInput) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res := make([]any, len(v))
+	for i := range v {
+		var err error
+		res[i], err = marshalPostInputN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostInput(ctx, v[i])
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+	}
+	return res, nil
+}
+
+func marshalPostMetaInputN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostMetaInput(ctx context.Context, v PostMetaInput) (any, error) {
+	res := map[string]any{}
+	if f, err := marshalBoolean2ᚖbool(ctx, v.Featured); err != nil {
+		return nil, fmt.Errorf("featured: %w", err)
+	} else if f != nil {
+		res["featured"] = f
+	}
+	if f, err := marshalLPostInputN2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostInput(ctx, v.Related); err != nil {
+		return nil, fmt.Errorf("related: %w", err)
+	} else if f != nil {
+		res["related"] = f
+	}
+	return res, nil
+}
+
+func marshalPostMetaInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostMetaInput(ctx context.Context, v *PostMetaInput) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return marshalPostMetaInputN2githubᚗcomᚋ99designsᚋgqlgenᚋclientᚋclientgenᚋoutᚐPostMetaInput(ctx, *v)
+}
@@ -5,11 +5,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
 	"net/textproto"
+	"strconv"
 	"strings"
+	"sync"
 )
 
+// transportSSEStreamTokenHeader mirrors transport.SSEStreamTokenHeader, the client package does
+// not depend on the handler packages.
+const transportSSEStreamTokenHeader = "X-GraphQL-Event-Stream-Token"
+
 type SSE struct {
 	Close func() error
 	Next  func(response any) error
@@ -103,3 +111,273 @@ func (p *Client) SSE(ctx context.Context, query string, options ...Option) *SSE
 		},
 	}
 }
+
+// SSEStream is an event stream in the single connection mode of the graphql-sse protocol. Any
+// number of operations can be started on it with Subscribe, their results are all delivered over
+// the one stream.
+type SSEStream struct {
+	client  *Client
+	srv     *httptest.Server
+	path    string
+	header  http.Header
+	token   string
+	cancel  context.CancelFunc
+	body    io.Closer
+	readErr error
+
+	mu     sync.Mutex
+	nextID int
+	ops    map[string]chan sseStreamEvent
+	done   chan struct{}
+}
+
+type sseStreamEvent struct {
+	event   string
+	payload json.RawMessage
+}
+
+// SSEStream reserves a stream token and opens the event stream. Options are applied to the
+// reservation and the stream requests, so headers set with AddHeader are sent with both.
+func (p *Client) SSEStream(ctx context.Context, options ...Option) (*SSEStream, error) {
+	r, err := p.newRequest("", options...)
+	if err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+
+	s := &SSEStream{
+		client: p,
+		srv:    httptest.NewServer(p.h),
+		path:   r.URL.Path,
+		header: r.Header.Clone(),
+		ops:    map[string]chan sseStreamEvent{},
+		done:   make(chan struct{}),
+	}
+	s.header.Del("Content-Type")
+
+	reserve, err := s.newRequest(ctx, http.MethodPut, s.path, nil)
+	if err != nil {
+		s.srv.Close()
+		return nil, err
+	}
+	resp, err := s.srv.Client().Do(reserve)
+	if err != nil {
+		s.srv.Close()
+		return nil, fmt.Errorf("reserve: %w", err)
+	}
+	token, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		s.srv.Close()
+		return nil, fmt.Errorf("reserve: %w", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		s.srv.Close()
+		return nil, fmt.Errorf("reserve: http %d: %s", resp.StatusCode, token)
+	}
+	s.token = string(token)
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	stream, err := s.newRequest(ctx, http.MethodGet, s.path, nil)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	stream.Header.Set("Accept", "text/event-stream")
+	resp, err = s.srv.Client().Do(stream)
+	if err != nil {
+		s.Close()
+		return nil, fmt.Errorf("stream: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		s.Close()
+		return nil, fmt.Errorf("stream: http %d: %s", resp.StatusCode, b)
+	}
+
+	reader := textproto.NewReader(bufio.NewReader(resp.Body))
+	line, err := reader.ReadLine()
+	if err != nil {
+		resp.Body.Close()
+		s.Close()
+		return nil, fmt.Errorf("stream: %w", err)
+	}
+	if line != ":" {
+		resp.Body.Close()
+		s.Close()
+		return nil, fmt.Errorf("expected :, got %s", line)
+	}
+
+	s.body = resp.Body
+	go s.read(reader)
+
+	return s, nil
+}
+
+// Token returns the stream token reserved for this stream.
+func (s *SSEStream) Token() string {
+	return s.token
+}
+
+func (s *SSEStream) newRequest(
+	ctx context.Context,
+	method, target string,
+	body io.Reader,
+) (*http.Request, error) {
+	r, err := http.NewRequestWithContext(ctx, method, s.srv.URL+target, body)
+	if err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+	r.Header = s.header.Clone()
+	if s.token != "" {
+		r.Header.Set(transportSSEStreamTokenHeader, s.token)
+	}
+	return r, nil
+}
+
+func (s *SSEStream) read(reader *textproto.Reader) {
+	var event string
+	for {
+		line, err := reader.ReadLine()
+		if err != nil {
+			s.mu.Lock()
+			s.readErr = err
+			for _, ch := range s.ops {
+				close(ch)
+			}
+			s.ops = map[string]chan sseStreamEvent{}
+			close(s.done)
+			s.mu.Unlock()
+			return
+		}
+		kv := strings.SplitN(line, ": ", 2)
+		switch kv[0] {
+		case "event":
+			event = kv[1]
+		case "data":
+			var msg struct {
+				ID      string          `json:"id"`
+				Payload json.RawMessage `json:"payload"`
+			}
+			if err := json.Unmarshal([]byte(kv[1]), &msg); err != nil {
+				continue
+			}
+			s.mu.Lock()
+			ch := s.ops[msg.ID]
+			if event == "complete" {
+				delete(s.ops, msg.ID)
+			}
+			s.mu.Unlock()
+			if ch != nil {
+				ch <- sseStreamEvent{event: event, payload: msg.Payload}
+				if event == "complete" {
+					close(ch)
+				}
+			}
+		}
+	}
+}
+
+// Subscribe starts an operation on the stream. Next returns nil once the operation is complete,
+// Close stops it.
+func (s *SSEStream) Subscribe(query string, options ...Option) *SSE {
+	s.mu.Lock()
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	ch := make(chan sseStreamEvent, 16)
+	s.ops[id] = ch
+	s.mu.Unlock()
+
+	opts := append([]Option{}, options...)
+	opts = append(opts, func(bd *Request) {
+		if bd.Extensions == nil {
+			bd.Extensions = map[string]any{}
+		}
+		bd.Extensions["operationId"] = id
+	})
+	r, err := s.client.newRequest(query, opts...)
+	if err != nil {
+		return errorSSE(fmt.Errorf("request: %w", err))
+	}
+	post, err := s.newRequest(r.Context(), http.MethodPost, s.path, r.Body)
+	if err != nil {
+		return errorSSE(err)
+	}
+	post.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.srv.Client().Do(post)
+	if err != nil {
+		return errorSSE(fmt.Errorf("post: %w", err))
+	}
+	b, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		s.mu.Lock()
+		delete(s.ops, id)
+		s.mu.Unlock()
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusUnprocessableEntity {
+			var respDataRaw Response
+			if err := json.Unmarshal(b, &respDataRaw); err == nil && respDataRaw.Errors != nil {
+				return errorSSE(RawJsonError{respDataRaw.Errors})
+			}
+		}
+		return errorSSE(fmt.Errorf("post: http %d: %s", resp.StatusCode, b))
+	}
+
+	return &SSE{
+		Close: func() error {
+			r, err := s.newRequest(context.Background(), http.MethodDelete, s.path+"?operationId="+id, nil)
+			if err != nil {
+				return err
+			}
+			resp, err := s.srv.Client().Do(r)
+			if err != nil {
+				return err
+			}
+			return resp.Body.Close()
+		},
+		Next: func(response any) error {
+			ev, ok := <-ch
+			if !ok {
+				if s.readErr != nil {
+					return s.readErr
+				}
+				return io.EOF
+			}
+			switch ev.event {
+			case "complete":
+				return nil
+			case "next":
+			default:
+				return fmt.Errorf("expected event type: %#v", ev.event)
+			}
+
+			var respDataRaw SSEResponse
+			if err := json.Unmarshal(ev.payload, &respDataRaw); err != nil {
+				return fmt.Errorf("decode: %w", err)
+			}
+
+			// we want to unpack even if there is an error, so we can see partial responses
+			unpackErr := unpack(respDataRaw, response, s.client.dc)
+
+			if respDataRaw.Errors != nil {
+				return RawJsonError{respDataRaw.Errors}
+			}
+
+			return unpackErr
+		},
+	}
+}
+
+// Close closes the event stream, which stops all of its operations.
+func (s *SSEStream) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.body != nil {
+		_ = s.body.Close()
+		<-s.done
+	}
+	s.srv.Close()
+	return nil
+}
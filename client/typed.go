@@ -0,0 +1,136 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Doer sends GraphQL requests for the typed clients generated by `gqlgen client`. Client
+// implements it by calling an http.Handler directly, Remote by calling a GraphQL endpoint, so
+// services and their tests can share one generated client.
+type Doer interface {
+	Do(ctx context.Context, query string, options ...Option) (*Response, error)
+}
+
+var (
+	_ Doer = &Client{}
+	_ Doer = &Remote{}
+)
+
+// Do sends the query to the client's handler. Unlike RawPost the request carries ctx, and
+// numbers in the response data are decoded as json.Number so they can be unmarshalled without
+// losing precision.
+func (p *Client) Do(ctx context.Context, query string, options ...Option) (*Response, error) {
+	r, err := p.newRequest(query, options...)
+	if err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+
+	w := httptest.NewRecorder()
+	p.h.ServeHTTP(w, r.WithContext(ctx))
+
+	return decodeResponse(w.Code, w.Body)
+}
+
+// Remote sends GraphQL requests to an endpoint over HTTP.
+type Remote struct {
+	url  string
+	opts []Option
+
+	// HTTPClient is used to send requests, it defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewRemote creates a client for the GraphQL endpoint at url. Options are applied to every
+// request, e.g. AddHeader for authentication.
+func NewRemote(url string, opts ...Option) *Remote {
+	return &Remote{
+		url:  url,
+		opts: opts,
+	}
+}
+
+func (r *Remote) Do(ctx context.Context, query string, options ...Option) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+	req, err = buildRequest(req, r.opts, query, options...)
+	if err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+	req.Header.Set("Accept", "application/graphql-response+json, application/json")
+
+	httpClient := r.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return decodeResponse(res.StatusCode, res.Body)
+}
+
+func decodeResponse(code int, body io.Reader) (*Response, error) {
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+
+	resp := &Response{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(resp); err != nil {
+		if code >= http.StatusBadRequest {
+			return nil, fmt.Errorf("http %d: %s", code, b)
+		}
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	// servers following the GraphQL over HTTP spec answer invalid requests with a 4xx status and
+	// a well-formed response, which carries more detail than the status.
+	if code >= http.StatusBadRequest && resp.Errors == nil {
+		return nil, fmt.Errorf("http %d: %s", code, b)
+	}
+
+	return resp, nil
+}
+
+// Execute sends an operation for a generated client and returns the response data. When the
+// server reports errors they are returned as a gqlerror.List together with the partial data.
+func Execute(
+	ctx context.Context,
+	d Doer,
+	query, operationName string,
+	variables map[string]any,
+) (map[string]any, error) {
+	options := []Option{Operation(operationName)}
+	for name, value := range variables {
+		options = append(options, Var(name, value))
+	}
+
+	resp, err := d.Do(ctx, query, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _ := resp.Data.(map[string]any)
+	if len(resp.Errors) == 0 || string(resp.Errors) == "null" {
+		return data, nil
+	}
+
+	var errs gqlerror.List
+	if err := json.Unmarshal(resp.Errors, &errs); err != nil {
+		return data, RawJsonError{resp.Errors}
+	}
+	return data, errs
+}
@@ -25,6 +25,7 @@ type FieldArgument struct {
 	Directives                     []*Directive
 	Value                          any // value set in Data
 	CallArgumentDirectivesWithNull bool
+	Constraint                     *Constraint // The @constraint of the argument, if any
 }
 
 // ImplDirectives get not SkipRuntime and location ARGUMENT_DEFINITION directive
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"go/token"
 	"go/types"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2/ast"
@@ -230,6 +232,7 @@ type TypeReference struct {
 	PointersInUnmarshalInput bool        // Inverse values and pointers in return.
 	IsRoot                   bool        // Is the type a root level definition such as Query, Mutation or Subscription
 	EnumValues               []EnumValueReference
+	SemanticNonNullLevels    []int // List levels of a @semanticNonNull field, 0 being the field itself
 }
 
 func (ref *TypeReference) Elem() *TypeReference {
@@ -243,11 +246,23 @@ func (ref *TypeReference) Elem() *TypeReference {
 		newRef := *ref
 		newRef.GO = ref.GO.(*types.Slice).Elem()
 		newRef.GQL = ref.GQL.Elem
+		newRef.SemanticNonNullLevels = nil
+		for _, level := range ref.SemanticNonNullLevels {
+			if level > 0 {
+				newRef.SemanticNonNullLevels = append(newRef.SemanticNonNullLevels, level-1)
+			}
+		}
 		return &newRef
 	}
 	return nil
 }
 
+// IsSemanticNonNull reports whether the type is nullable in the schema but marked with
+// @semanticNonNull, its values are only null on errors and a null does not bubble up.
+func (ref *TypeReference) IsSemanticNonNull() bool {
+	return !ref.GQL.NonNull && slices.Contains(ref.SemanticNonNullLevels, 0)
+}
+
 func (ref *TypeReference) IsPtr() bool {
 	_, isPtr := ref.GO.(*types.Pointer)
 	return isPtr
@@ -317,9 +332,13 @@ func (ref *TypeReference) UniquenessKey() string {
 		// Fix for #896
 		elemNullability = "ᚄ"
 	}
+	semanticNullability := ""
+	for _, level := range ref.SemanticNonNullLevels {
+		semanticNullability += "ᚅ" + strconv.Itoa(level)
+	}
 	return nullability + ref.Definition.Name + "2" + templates.TypeIdentifier(
 		ref.GO,
-	) + elemNullability
+	) + elemNullability + semanticNullability
 }
 
 func (ref *TypeReference) MarshalFunc() string {
@@ -343,7 +362,8 @@ func (ref *TypeReference) UnmarshalFunc() string {
 		panic(errors.New("Definition missing for " + ref.GQL.Name()))
 	}
 
-	if !ref.Definition.IsInputType() {
+	// @semanticNonNull only marks fields of output types
+	if !ref.Definition.IsInputType() || len(ref.SemanticNonNullLevels) > 0 {
 		return ""
 	}
 
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// ClientConfig configures the typed client generated by `gqlgen client` from operation files.
+type ClientConfig struct {
+	Filename   string `yaml:"filename,omitempty"`
+	Package    string `yaml:"package,omitempty"`
+	ImportPath string `yaml:"import_path,omitempty"`
+	// Operations lists the .graphql files holding the operations and fragments to generate
+	// functions for, glob patterns are expanded.
+	Operations StringList `yaml:"operations,omitempty"`
+}
+
+func (c *ClientConfig) IsDefined() bool {
+	return c.Filename != ""
+}
+
+func (c *ClientConfig) Dir() string {
+	if !c.IsDefined() {
+		return ""
+	}
+	return filepath.Dir(c.Filename)
+}
+
+func (c *ClientConfig) GetImportPath() string {
+	if !c.IsDefined() {
+		return ""
+	}
+	if c.ImportPath != "" {
+		return c.ImportPath
+	}
+	return code.ImportPathForDir(c.Dir())
+}
+
+// Check validates the config and expands the operation globs.
+func (c *ClientConfig) Check() error {
+	if strings.ContainsAny(c.Package, "./\\") {
+		return errors.New(
+			"package should be the output package name only, do not include the output filename",
+		)
+	}
+	if c.Filename == "" {
+		return errors.New("filename must be specified")
+	}
+	if !strings.HasSuffix(c.Filename, ".go") {
+		return errors.New("filename should be path to a go source file")
+	}
+	c.Filename = abs(c.Filename)
+	if c.Package == "" {
+		c.Package = code.NameForDir(c.Dir())
+	}
+
+	var operations StringList
+	for _, pattern := range c.Operations {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("failed to glob operations %s: %w", pattern, err)
+		}
+		for _, m := range matches {
+			if !operations.Has(m) {
+				operations = append(operations, m)
+			}
+		}
+	}
+	if len(operations) == 0 {
+		return errors.New("no operation files found")
+	}
+	c.Operations = operations
+
+	return nil
+}
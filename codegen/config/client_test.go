@@ -0,0 +1,44 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientConfig(t *testing.T) {
+	t.Run("expands operation globs", func(t *testing.T) {
+		c := ClientConfig{
+			Filename:   "testdata/example.go",
+			Operations: StringList{"testdata/cfg/*.yml", "testdata/cfg/gqlgen.yml"},
+		}
+		require.True(t, c.IsDefined())
+		require.NoError(t, c.Check())
+
+		require.Equal(t, "config_test_data", c.Package)
+		require.Equal(t, "github.com/99designs/gqlgen/codegen/config/testdata", c.GetImportPath())
+		require.Contains(t, filepath.ToSlash(c.Filename), "codegen/config/testdata/example.go")
+
+		require.NotEmpty(t, c.Operations)
+		seen := map[string]bool{}
+		for _, op := range c.Operations {
+			require.True(t, strings.HasPrefix(filepath.ToSlash(op), "testdata/cfg/"))
+			require.False(t, seen[op], "%s is listed twice", op)
+			seen[op] = true
+		}
+	})
+
+	t.Run("when given no operations", func(t *testing.T) {
+		c := ClientConfig{Filename: "testdata/example.go", Operations: StringList{"testdata/nope/*.graphql"}}
+		require.EqualError(t, c.Check(), "no operation files found")
+	})
+
+	t.Run("when given nothing", func(t *testing.T) {
+		c := ClientConfig{}
+		require.False(t, c.IsDefined())
+		require.EqualError(t, c.Check(), "filename must be specified")
+		require.Empty(t, c.GetImportPath())
+	})
+}
@@ -23,10 +23,16 @@ import (
 
 type Config struct {
 	SchemaFilename                       StringList                 `yaml:"schema,omitempty"`
+	SchemaEndpoints                      map[string]SchemaEndpoint  `yaml:"schema_endpoints,omitempty"`
+	SchemaLockfile                       string                     `yaml:"schema_lockfile,omitempty"`
 	Exec                                 ExecConfig                 `yaml:"exec"`
 	Model                                PackageConfig              `yaml:"model,omitempty"`
 	Federation                           PackageConfig              `yaml:"federation,omitempty"`
 	Resolver                             ResolverConfig             `yaml:"resolver,omitempty"`
+	Client                               ClientConfig               `yaml:"client,omitempty"`
+	Lint                                 LintConfig                 `yaml:"lint,omitempty"`
+	Constraints                          ConstraintConfig           `yaml:"constraints,omitempty"`
+	Contracts                            map[string]ContractConfig  `yaml:"contracts,omitempty"`
 	AutoBind                             []string                   `yaml:"autobind"`
 	Models                               TypeMap                    `yaml:"models,omitempty"`
 	StructTag                            string                     `yaml:"struct_tag,omitempty"`
@@ -66,6 +72,7 @@ type Config struct {
 	Sources                        []*ast.Source  `yaml:"-"`
 	Packages                       *code.Packages `yaml:"-"`
 	Schema                         *ast.Schema    `yaml:"-"`
+	RefreshSchemaEndpoints         bool           `yaml:"-"`
 
 	// Deprecated: use Federation instead. Will be removed next release
 	Federated bool `yaml:"federated,omitempty"`
@@ -203,6 +210,14 @@ func CompleteConfig(config *Config) error {
 	preGlobbing := config.SchemaFilename
 	config.SchemaFilename = StringList{}
 	for _, f := range preGlobbing {
+		// endpoints are introspected when the schema is loaded
+		if IsSchemaEndpoint(f) {
+			if !config.SchemaFilename.Has(f) {
+				config.SchemaFilename = append(config.SchemaFilename, f)
+			}
+			continue
+		}
+
 		var matches []string
 
 		// for ** we want to override default globbing patterns and walk all
@@ -248,6 +263,9 @@ func CompleteConfig(config *Config) error {
 	}
 
 	for _, filename := range config.SchemaFilename {
+		if IsSchemaEndpoint(filename) {
+			continue
+		}
 		filename = filepath.ToSlash(filename)
 		var err error
 		var schemaRaw []byte
@@ -325,9 +343,23 @@ func (c *Config) IsRoot(def *ast.Definition) bool {
 }
 
 func (c *Config) injectTypesFromSchema() error {
-	for _, d := range []string{"goModel", "goExtraField", "goField", "goTag", "goEnum", "inlineArguments"} {
+	for _, d := range []string{"goModel", "goExtraField", "goField", "goTag", "goEnum", "inlineArguments", "connection", "constraint", "semanticNonNull", "experimental_disableErrorPropagation"} {
 		c.Directives[d] = DirectiveConfig{SkipRuntime: true}
 	}
+	if _, ok := c.Directives["tag"]; !ok && len(c.Contracts) > 0 {
+		c.Directives["tag"] = DirectiveConfig{SkipRuntime: true}
+	}
+	if d := c.Schema.Directives["timeout"]; d != nil && d.Arguments.ForName("ms") != nil {
+		if _, ok := c.Directives["timeout"]; !ok {
+			c.Directives["timeout"] = DirectiveConfig{SkipRuntime: true}
+		}
+	}
+	// @sensitive is read from the schema by the redactor of the logging extensions
+	if _, ok := c.Schema.Directives["sensitive"]; ok {
+		if _, ok := c.Directives["sensitive"]; !ok {
+			c.Directives["sensitive"] = DirectiveConfig{SkipRuntime: true}
+		}
+	}
 
 	for _, schemaType := range c.Schema.Types {
 		if c.IsRoot(schemaType) {
@@ -494,6 +526,11 @@ func (c *Config) injectTypesFromSchema() error {
 		}
 	}
 
+	// connections are graphql.Connection instances, their page info has to be the runtime one
+	if len(Connections(c.Schema)) > 0 && !c.Models.UserDefined("PageInfo") {
+		c.Models.Add("PageInfo", "github.com/99designs/gqlgen/graphql.PageInfo")
+	}
+
 	return nil
 }
 
@@ -502,6 +539,9 @@ type TypeMapEntry struct {
 	ForceGenerate bool                    `yaml:"forceGenerate,omitempty"`
 	Fields        map[string]TypeMapField `yaml:"fields,omitempty"`
 	EnumValues    map[string]EnumValue    `yaml:"enum_values,omitempty"`
+	// Timeout is the default time the resolvers of the fields of the type have to return, as
+	// parsed by time.ParseDuration. @timeout in the schema takes precedence over it.
+	Timeout string `yaml:"timeout,omitempty"`
 
 	// Key is the Go name of the field.
 	ExtraFields      map[string]ModelExtraField `yaml:"extraFields,omitempty"`
@@ -530,6 +570,7 @@ type TypeMapField struct {
 	Resolver        bool   `yaml:"resolver"`
 	FieldName       string `yaml:"fieldName"`
 	Omittable       *bool  `yaml:"omittable"`
+	Timeout         string `yaml:"timeout"`
 	GeneratedMethod string `yaml:"-"`
 }
 
@@ -783,6 +824,11 @@ func inStrSlice(haystack []string, needle string) bool {
 	return false
 }
 
+// FindConfig returns the path of the config file LoadConfigFromDefaultLocations would load.
+func FindConfig() (string, error) {
+	return findCfg()
+}
+
 // findCfg searches for the config file in this directory and all parents up the tree
 // looking for the closest match
 func findCfg() (string, error) {
@@ -952,10 +998,19 @@ func (c *Config) injectBuiltins() {
 			c.Models[typeName] = entry
 		}
 	}
+
+	for typeName, def := range c.Schema.Types {
+		if c.Models.Exists(typeName) {
+			continue
+		}
+		if model, ok := specifiedByModel(def); ok {
+			c.Models[typeName] = TypeMapEntry{Model: model}
+		}
+	}
 }
 
 func (c *Config) LoadSchema() error {
-	if c.Packages != nil {
+	if c.Packages != nil && !c.Packages.ReloadsSelectively() {
 		c.Packages = code.NewPackages(
 			code.WithBuildTags(c.GoBuildTags...),
 			code.PackagePrefixToCache("github.com/99designs/gqlgen/graphql"),
@@ -967,6 +1022,16 @@ func (c *Config) LoadSchema() error {
 		return err
 	}
 
+	if err := c.resolveSources(); err != nil {
+		return err
+	}
+
+	sources, err := AppendConnectionSource(c.Sources)
+	if err != nil {
+		return err
+	}
+	c.Sources = sources
+
 	schema, err := gqlparser.LoadSchema(c.Sources...)
 	if err != nil {
 		return err
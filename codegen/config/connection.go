@@ -0,0 +1,130 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// ConnectionSourceName is the name of the source holding the generated connection types.
+const ConnectionSourceName = "connection/connections.graphql"
+
+// Connection names the types generated for a type marked with @connection.
+type Connection struct {
+	Node       string
+	Connection string
+	Edge       string
+}
+
+func newConnection(node string) Connection {
+	return Connection{Node: node, Connection: node + "Connection", Edge: node + "Edge"}
+}
+
+// Connections returns the connections of the types marked with @connection, ordered by node name.
+func Connections(schema *ast.Schema) []Connection {
+	var res []Connection
+	for _, def := range schema.Types {
+		if def.Directives.ForName("connection") != nil {
+			res = append(res, newConnection(def.Name))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Node < res[j].Node })
+	return res
+}
+
+// ConnectionSource returns the connection and edge types of the types marked with @connection
+// in sources, following the Relay cursor connections specification. The @connection directive
+// and PageInfo are declared as well, unless sources already do. It returns nil if no type is
+// marked.
+func ConnectionSource(sources []*ast.Source) (*ast.Source, error) {
+	var nodes []string
+	defined := map[string]bool{}
+	directiveDeclared := false
+	for _, src := range sources {
+		if src.Name == ConnectionSourceName {
+			continue
+		}
+		// syntax errors are reported when loading the schema
+		doc, err := parser.ParseSchema(src)
+		if err != nil {
+			continue
+		}
+		for _, d := range doc.Directives {
+			directiveDeclared = directiveDeclared || d.Name == "connection"
+		}
+		for _, def := range doc.Definitions {
+			defined[def.Name] = true
+		}
+		for _, def := range append(doc.Definitions, doc.Extensions...) {
+			if def.Directives.ForName("connection") == nil || slices.Contains(nodes, def.Name) {
+				continue
+			}
+			switch def.Kind {
+			case ast.Object, ast.Interface, ast.Union:
+				nodes = append(nodes, def.Name)
+			default:
+				return nil, fmt.Errorf("%s: @connection can only be used on objects, interfaces and unions", def.Name)
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	sort.Strings(nodes)
+
+	var sb strings.Builder
+	if !directiveDeclared {
+		sb.WriteString("directive @connection on OBJECT | INTERFACE | UNION\n\n")
+	}
+	if !defined["PageInfo"] {
+		sb.WriteString(`type PageInfo {
+	hasNextPage: Boolean!
+	hasPreviousPage: Boolean!
+	startCursor: String
+	endCursor: String
+}
+`)
+	}
+	for _, node := range nodes {
+		c := newConnection(node)
+		for _, name := range []string{c.Connection, c.Edge} {
+			if defined[name] {
+				return nil, fmt.Errorf("%s: %s is generated for @connection, remove its definition", node, name)
+			}
+		}
+		fmt.Fprintf(&sb, `
+type %s {
+	edges: [%s!]!
+	pageInfo: PageInfo!
+	totalCount: Int!
+}
+
+type %s {
+	node: %s!
+	cursor: String!
+}
+`, c.Connection, c.Edge, c.Edge, c.Node)
+	}
+
+	return &ast.Source{Name: ConnectionSourceName, Input: sb.String(), BuiltIn: true}, nil
+}
+
+// AppendConnectionSource returns sources with the source returned by ConnectionSource in place of
+// any previous one.
+func AppendConnectionSource(sources []*ast.Source) ([]*ast.Source, error) {
+	sources = slices.DeleteFunc(sources, func(s *ast.Source) bool {
+		return s.Name == ConnectionSourceName
+	})
+	connections, err := ConnectionSource(sources)
+	if err != nil {
+		return nil, err
+	}
+	if connections != nil {
+		sources = append(sources, connections)
+	}
+	return sources, nil
+}
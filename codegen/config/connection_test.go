@@ -0,0 +1,66 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestConnectionSource(t *testing.T) {
+	t.Run("generates connection and edge types", func(t *testing.T) {
+		sources, err := AppendConnectionSource([]*ast.Source{{Name: "schema.graphql", Input: `
+			type Query { users(first: Int, after: String): UserConnection! }
+			type User @connection { id: ID! }
+			type Post { id: ID! }
+			extend type Post @connection
+		`}})
+		require.NoError(t, err)
+		require.Len(t, sources, 2)
+		require.True(t, sources[1].BuiltIn)
+
+		schema, err := gqlparser.LoadSchema(sources...)
+		require.NoError(t, err)
+		require.Equal(t, []Connection{
+			{Node: "Post", Connection: "PostConnection", Edge: "PostEdge"},
+			{Node: "User", Connection: "UserConnection", Edge: "UserEdge"},
+		}, Connections(schema))
+		require.Equal(t, "[UserEdge!]!", schema.Types["UserConnection"].Fields.ForName("edges").Type.String())
+		require.Equal(t, "User!", schema.Types["UserEdge"].Fields.ForName("node").Type.String())
+		require.NotNil(t, schema.Types["PageInfo"])
+
+		// appending again replaces the generated source
+		sources, err = AppendConnectionSource(sources)
+		require.NoError(t, err)
+		require.Len(t, sources, 2)
+	})
+
+	t.Run("keeps declarations from the schema", func(t *testing.T) {
+		source, err := ConnectionSource([]*ast.Source{{Input: `
+			directive @connection on OBJECT
+			type PageInfo { hasNextPage: Boolean! hasPreviousPage: Boolean! }
+			type User @connection { id: ID! }
+		`}})
+		require.NoError(t, err)
+		require.NotContains(t, source.Input, "directive @connection")
+		require.NotContains(t, source.Input, "type PageInfo")
+	})
+
+	t.Run("without connections", func(t *testing.T) {
+		source, err := ConnectionSource([]*ast.Source{{Input: `type Query { id: ID }`}})
+		require.NoError(t, err)
+		require.Nil(t, source)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := ConnectionSource([]*ast.Source{{Input: `input Filter @connection { id: ID }`}})
+		require.EqualError(t, err, "Filter: @connection can only be used on objects, interfaces and unions")
+
+		_, err = ConnectionSource([]*ast.Source{{Input: `
+			type User @connection { id: ID! }
+			type UserEdge { node: User }
+		`}})
+		require.EqualError(t, err, "User: UserEdge is generated for @connection, remove its definition")
+	})
+}
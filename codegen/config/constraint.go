@@ -0,0 +1,9 @@
+package config
+
+// ConstraintConfig configures the @constraint directive.
+type ConstraintConfig struct {
+	// Formats registers the formats @constraint(format:) accepts besides the built in ones, keyed
+	// by name. Each is a function with the signature func(string) error, given as its package path
+	// and name, eg github.com/my/app/validate.Slug.
+	Formats map[string]string `yaml:"formats,omitempty"`
+}
@@ -0,0 +1,11 @@
+package config
+
+// ContractConfig is a schema contract, a variant of the schema served to some clients. It keeps
+// the types and fields selected by their @tag(name:) directives.
+type ContractConfig struct {
+	// Include keeps only the fields tagged with one of these tags, or belonging to a type tagged
+	// with one of them. Every field is kept when it is empty.
+	Include StringList `yaml:"include,omitempty"`
+	// Exclude removes the elements tagged with one of these tags.
+	Exclude StringList `yaml:"exclude,omitempty"`
+}
@@ -21,6 +21,12 @@ type ExecConfig struct {
 	// Only for follow-schema layout:
 	FilenameTemplate string `yaml:"filename_template,omitempty"` // String template with {name} as placeholder for base name.
 	DirName          string `yaml:"dir"`
+	// Incremental records a hash of the inputs of every generated file and skips rendering the
+	// files whose inputs did not change since they were written.
+	Incremental bool `yaml:"incremental,omitempty"`
+	// InputDecoders generates decoders reading the input objects of variables straight from the
+	// JSON of POST requests, instead of decoding the variables to maps first.
+	InputDecoders bool `yaml:"input_decoders,omitempty"`
 
 	// Maximum number of goroutines in concurrency to use when running multiple child resolvers
 	// Suppressing the number of goroutines generated can reduce memory consumption per request,
@@ -54,6 +60,9 @@ func (r *ExecConfig) Check() error {
 				"filename should be path to a go source file when using single-file layout",
 			)
 		}
+		if r.Incremental {
+			return errors.New("incremental is only supported with follow-schema layout")
+		}
 		r.Filename = abs(r.Filename)
 	case ExecLayoutFollowSchema:
 		if r.DirName == "" {
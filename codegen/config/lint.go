@@ -0,0 +1,14 @@
+package config
+
+// LintConfig configures the rules run by `gqlgen lint`, keyed by rule name. Rules that are not
+// listed run with their default severity.
+type LintConfig struct {
+	Rules map[string]LintRuleConfig `yaml:"rules,omitempty"`
+}
+
+type LintRuleConfig struct {
+	// Severity is one of error, warning, info or off.
+	Severity string `yaml:"severity,omitempty"`
+	// Ignore lists the types, or fields as Type.field, the rule is not applied to.
+	Ignore StringList `yaml:"ignore,omitempty"`
+}
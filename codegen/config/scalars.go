@@ -0,0 +1,55 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// specifiedByScalars are the scalars of the graphql/scalars package, by the name they are
+// declared with. They are bound when the schema declares them with the URL of their
+// specification on graphql-scalars.dev, the path of the URL is given here.
+var specifiedByScalars = map[string]string{
+	"LocalTime":        "local-time",
+	"DateTime":         "date-time",
+	"BigInt":           "big-int",
+	"Decimal":          "decimal",
+	"JSON":             "json",
+	"URL":              "url",
+	"EmailAddress":     "email-address",
+	"NonNegativeInt":   "non-negative-int",
+	"PositiveInt":      "positive-int",
+	"NonNegativeFloat": "non-negative-float",
+}
+
+// specifiedByURLPrefixes are the locations the documentation of graphql-scalars.dev has been
+// published at.
+var specifiedByURLPrefixes = []string{
+	"https://the-guild.dev/graphql/scalars/docs/scalars/",
+	"https://www.graphql-scalars.dev/docs/scalars/",
+}
+
+// specifiedByModel returns the model of the graphql/scalars package for def, if def is a scalar
+// declared with the @specifiedBy URL of one of them.
+func specifiedByModel(def *ast.Definition) (StringList, bool) {
+	path, ok := specifiedByScalars[def.Name]
+	if !ok || def.Kind != ast.Scalar {
+		return nil, false
+	}
+	d := def.Directives.ForName("specifiedBy")
+	if d == nil {
+		return nil, false
+	}
+	arg := d.Arguments.ForName("url")
+	if arg == nil || arg.Value == nil {
+		return nil, false
+	}
+
+	url := strings.TrimSuffix(arg.Value.Raw, "/")
+	for _, prefix := range specifiedByURLPrefixes {
+		if url == prefix+path {
+			return StringList{"github.com/99designs/gqlgen/graphql/scalars." + def.Name + "Context"}, true
+		}
+	}
+	return nil, false
+}
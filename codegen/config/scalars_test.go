@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestInjectSpecifiedByScalars(t *testing.T) {
+	cfg := &Config{
+		Models: TypeMap{
+			"URL": {Model: StringList{"net/url.URL"}},
+		},
+		Schema: gqlparser.MustLoadSchema(&ast.Source{Input: `
+			type Query { a: String }
+			scalar DateTime @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/date-time")
+			scalar BigInt @specifiedBy(url: "https://www.graphql-scalars.dev/docs/scalars/big-int/")
+			scalar URL @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/url")
+			scalar Decimal @specifiedBy(url: "https://example.com/decimal")
+			scalar JSON
+		`}),
+	}
+	cfg.injectBuiltins()
+
+	assert.Equal(t, StringList{"github.com/99designs/gqlgen/graphql/scalars.DateTimeContext"}, cfg.Models["DateTime"].Model)
+	assert.Equal(t, StringList{"github.com/99designs/gqlgen/graphql/scalars.BigIntContext"}, cfg.Models["BigInt"].Model)
+	assert.Equal(t, StringList{"net/url.URL"}, cfg.Models["URL"].Model)
+	assert.False(t, cfg.Models.Exists("Decimal"))
+	assert.False(t, cfg.Models.Exists("JSON"))
+}
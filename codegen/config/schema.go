@@ -0,0 +1,178 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlintrospection "github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/99designs/gqlgen/internal/introspection"
+)
+
+// DefaultSchemaLockfile is where the schemas fetched from endpoints are kept when
+// schema_lockfile is not set.
+const DefaultSchemaLockfile = "gqlgen.schema.lock"
+
+// SchemaEndpoint configures how a schema listed as an http(s) URL is introspected.
+type SchemaEndpoint struct {
+	// Headers are sent with the introspection query, environment variables in the values are
+	// expanded so tokens don't have to be committed.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// schemaLock is the content of the schema lockfile, the SDL of every endpoint keyed by its URL.
+type schemaLock struct {
+	Endpoints map[string]lockedSchema `yaml:"endpoints"`
+}
+
+type lockedSchema struct {
+	SDL string `yaml:"sdl"`
+}
+
+const schemaFetchTimeout = 30 * time.Second
+
+// IsSchemaEndpoint reports whether a schema entry is an http(s) URL rather than a file.
+func IsSchemaEndpoint(filename string) bool {
+	return strings.HasPrefix(filename, "http://") || strings.HasPrefix(filename, "https://")
+}
+
+// resolveSources turns the introspection results among the sources into SDL and adds the
+// schemas of the endpoints listed in SchemaFilename. Endpoints are read from the lockfile, they
+// are only fetched when missing from it or when RefreshSchemaEndpoints is set.
+func (c *Config) resolveSources() error {
+	for i, s := range c.Sources {
+		if filepath.Ext(s.Name) != ".json" || !strings.HasPrefix(strings.TrimSpace(s.Input), "{") {
+			continue
+		}
+		source, err := introspection.Source(s.Name, []byte(s.Input))
+		if err != nil {
+			return err
+		}
+		c.Sources[i] = source
+	}
+
+	loaded := map[string]bool{}
+	for _, s := range c.Sources {
+		loaded[s.Name] = true
+	}
+	var endpoints []string
+	for _, filename := range c.SchemaFilename {
+		if IsSchemaEndpoint(filename) && !loaded[filename] {
+			endpoints = append(endpoints, filename)
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	lockfile := c.SchemaLockfile
+	if lockfile == "" {
+		lockfile = DefaultSchemaLockfile
+	}
+	lock, err := readSchemaLock(lockfile)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, url := range endpoints {
+		locked, ok := lock.Endpoints[url]
+		if !ok || c.RefreshSchemaEndpoints {
+			sdl, err := fetchSchema(url, c.SchemaEndpoints[url])
+			if err != nil {
+				return err
+			}
+			changed = changed || locked.SDL != sdl
+			locked = lockedSchema{SDL: sdl}
+			lock.Endpoints[url] = locked
+		}
+		c.Sources = append(c.Sources, &ast.Source{Name: url, Input: locked.SDL})
+	}
+
+	if changed {
+		return writeSchemaLock(lockfile, lock)
+	}
+	return nil
+}
+
+func readSchemaLock(filename string) (*schemaLock, error) {
+	lock := &schemaLock{}
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		lock.Endpoints = map[string]lockedSchema{}
+		return lock, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read schema lockfile: %w", err)
+	}
+	if err := yaml.Unmarshal(b, lock); err != nil {
+		return nil, fmt.Errorf("unable to parse schema lockfile %s: %w", filename, err)
+	}
+	if lock.Endpoints == nil {
+		lock.Endpoints = map[string]lockedSchema{}
+	}
+	return lock, nil
+}
+
+func writeSchemaLock(filename string, lock *schemaLock) error {
+	b, err := yaml.MarshalWithOptions(lock, yaml.UseLiteralStyleIfMultiline(true))
+	if err != nil {
+		return err
+	}
+	header := "# Code generated by gqlgen, DO NOT EDIT.\n" +
+		"# The schemas introspected from the endpoints listed in schema, refresh them with\n" +
+		"# gqlgen generate --refresh-schema.\n"
+	if err := os.WriteFile(filename, append([]byte(header), b...), 0o644); err != nil {
+		return fmt.Errorf("unable to write schema lockfile: %w", err)
+	}
+	return nil
+}
+
+// fetchSchema runs the introspection query against url and returns the schema as SDL.
+func fetchSchema(url string, endpoint SchemaEndpoint) (string, error) {
+	body, err := json.Marshal(map[string]any{
+		"operationName": "IntrospectionQuery",
+		"query":         gqlintrospection.Query,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("unable to introspect %s: %w", url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for name, value := range endpoint.Headers {
+		req.Header.Set(name, os.ExpandEnv(value))
+	}
+
+	client := &http.Client{Timeout: schemaFetchTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to introspect %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to introspect %s: %w", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to introspect %s: %s", url, resp.Status)
+	}
+	sdl, err := introspection.SDL(b)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", url, err)
+	}
+	return sdl, nil
+}
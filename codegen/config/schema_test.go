@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const introspectionResult = `{"data": {"__schema": {
+	"queryType": {"name": "Query"},
+	"types": [
+		{"kind": "OBJECT", "name": "Query", "fields": [
+			{"name": "hello", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}}
+		], "interfaces": []},
+		{"kind": "SCALAR", "name": "String"}
+	],
+	"directives": []
+}}}`
+
+func TestLoadSchemaFromIntrospection(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	t.Run("json files", func(t *testing.T) {
+		require.NoError(t, os.WriteFile("remote.json", []byte(introspectionResult), 0o644))
+		require.NoError(t, os.WriteFile("local.graphqls", []byte("extend type Query { local: Int }"), 0o644))
+
+		cfg := DefaultConfig()
+		cfg.SchemaFilename = StringList{"*.json", "*.graphqls"}
+		require.NoError(t, CompleteConfig(cfg))
+		require.NoError(t, cfg.LoadSchema())
+		require.NotNil(t, cfg.Schema.Query.Fields.ForName("hello"))
+		require.NotNil(t, cfg.Schema.Query.Fields.ForName("local"))
+
+		// loading again keeps the converted source
+		require.NoError(t, cfg.LoadSchema())
+		require.Equal(t, "remote.json", cfg.Schema.Query.Fields.ForName("hello").Position.Src.Name)
+	})
+
+	t.Run("endpoints", func(t *testing.T) {
+		var requests int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			var body struct{ Query string }
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Contains(t, body.Query, "__schema")
+			w.Write([]byte(introspectionResult))
+		}))
+		defer srv.Close()
+		t.Setenv("API_TOKEN", "secret")
+
+		load := func(refresh bool) *Config {
+			cfg := DefaultConfig()
+			cfg.SchemaFilename = StringList{srv.URL}
+			cfg.SchemaEndpoints = map[string]SchemaEndpoint{
+				srv.URL: {Headers: map[string]string{"Authorization": "Bearer ${API_TOKEN}"}},
+			}
+			cfg.SchemaLockfile = filepath.Join("locks", "schema.lock")
+			cfg.RefreshSchemaEndpoints = refresh
+			require.NoError(t, CompleteConfig(cfg))
+			require.NoError(t, cfg.LoadSchema())
+			require.NotNil(t, cfg.Schema.Query.Fields.ForName("hello"))
+			return cfg
+		}
+		require.NoError(t, os.Mkdir("locks", 0o755))
+
+		cfg := load(false)
+		require.Equal(t, 1, requests)
+		require.Equal(t, srv.URL, cfg.Schema.Query.Fields.ForName("hello").Position.Src.Name)
+		lock, err := os.ReadFile(filepath.Join("locks", "schema.lock"))
+		require.NoError(t, err)
+		require.Contains(t, string(lock), "hello: String!")
+
+		// loading again keeps the endpoint source
+		require.NoError(t, cfg.LoadSchema())
+		require.Equal(t, 1, requests)
+
+		// the lockfile is used until a refresh is asked for
+		load(false)
+		require.Equal(t, 1, requests)
+		load(true)
+		require.Equal(t, 2, requests)
+	})
+
+	t.Run("endpoint errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusUnauthorized)
+		}))
+		defer srv.Close()
+
+		cfg := DefaultConfig()
+		cfg.SchemaFilename = StringList{srv.URL}
+		require.NoError(t, CompleteConfig(cfg))
+		require.EqualError(t, cfg.LoadSchema(), "unable to introspect "+srv.URL+": 401 Unauthorized")
+		require.NoFileExists(t, DefaultSchemaLockfile)
+	})
+}
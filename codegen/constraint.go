@@ -0,0 +1,194 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// Constraint is a @constraint directive on an input field or argument, the generated code checks
+// the unmarshalled value against it.
+type Constraint struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   string
+	Min       *float64
+	Max       *float64
+	Format    string
+	// FormatFunc checks Format when it is registered in the config.
+	FormatFunc *types.Func
+}
+
+// buildConstraint returns the @constraint of a value of type typ, nil if it has none.
+func (b *builder) buildConstraint(typ *ast.Type, directives ast.DirectiveList) (*Constraint, error) {
+	d := directives.ForName("constraint")
+	if d == nil {
+		return nil, nil
+	}
+
+	named := typ
+	for named.Elem != nil {
+		named = named.Elem
+	}
+	var isString, isNumber bool
+	switch named.NamedType {
+	case "Int", "Float":
+		isNumber = true
+	case "Boolean":
+	default:
+		def := b.Schema.Types[named.NamedType]
+		isString = def != nil && (def.Kind == ast.Scalar || def.Kind == ast.Enum)
+		// custom scalars can hold numbers as well
+		isNumber = def != nil && def.Kind == ast.Scalar && named.NamedType != "String" && named.NamedType != "ID"
+	}
+
+	c := &Constraint{}
+	for _, arg := range d.Arguments {
+		value, err := arg.Value.Value(nil)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+
+		switch arg.Name {
+		case "minLength", "maxLength":
+			n, ok := value.(int64)
+			if !ok || n < 0 {
+				return nil, fmt.Errorf("@constraint(%s:) must be a non negative integer", arg.Name)
+			}
+			if !isString && typ.Elem == nil {
+				return nil, fmt.Errorf("@constraint(%s:) can only be used on strings and lists", arg.Name)
+			}
+			length := int(n)
+			if arg.Name == "minLength" {
+				c.MinLength = &length
+			} else {
+				c.MaxLength = &length
+			}
+		case "min", "max":
+			var n float64
+			switch value := value.(type) {
+			case int64:
+				n = float64(value)
+			case float64:
+				n = value
+			default:
+				return nil, fmt.Errorf("@constraint(%s:) must be a number", arg.Name)
+			}
+			if !isNumber {
+				return nil, fmt.Errorf("@constraint(%s:) can only be used on numbers", arg.Name)
+			}
+			if arg.Name == "min" {
+				c.Min = &n
+			} else {
+				c.Max = &n
+			}
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return nil, errors.New("@constraint(pattern:) must be a string")
+			}
+			if !isString {
+				return nil, fmt.Errorf("@constraint(pattern:) can only be used on strings")
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("@constraint(pattern:) is not a valid regular expression: %w", err)
+			}
+			c.Pattern = pattern
+		case "format":
+			format, ok := value.(string)
+			if !ok {
+				return nil, errors.New("@constraint(format:) must be a string")
+			}
+			if !isString {
+				return nil, fmt.Errorf("@constraint(format:) can only be used on strings")
+			}
+			c.Format = format
+			if err := b.bindConstraintFormat(c); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown @constraint argument %s", arg.Name)
+		}
+	}
+
+	if c.MinLength != nil && c.MaxLength != nil && *c.MinLength > *c.MaxLength {
+		return nil, errors.New("@constraint(minLength:) must not be greater than maxLength")
+	}
+	if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+		return nil, errors.New("@constraint(min:) must not be greater than max")
+	}
+
+	return c, nil
+}
+
+// bindConstraintFormat finds the function registered for the format of c in the config, the
+// built in formats are used otherwise.
+func (b *builder) bindConstraintFormat(c *Constraint) error {
+	name, registered := b.Config.Constraints.Formats[c.Format]
+	if !registered {
+		if _, ok := graphql.ConstraintFormats[c.Format]; !ok {
+			return fmt.Errorf(
+				"unknown @constraint format %q, expected one of %s or a format registered in constraints.formats",
+				c.Format,
+				strings.Join(slices.Sorted(maps.Keys(graphql.ConstraintFormats)), ", "),
+			)
+		}
+		return nil
+	}
+
+	pkgName, funcName := code.PkgAndType(name)
+	if pkgName == "" {
+		return fmt.Errorf("constraint format %s: %s must be a package path and function name", c.Format, name)
+	}
+	pkg := b.Config.Packages.LoadWithTypes(pkgName)
+	if pkg == nil {
+		return fmt.Errorf("constraint format %s: unable to load package %s", c.Format, pkgName)
+	}
+	fn, ok := pkg.Types.Scope().Lookup(funcName).(*types.Func)
+	if !ok {
+		return fmt.Errorf("constraint format %s: function %s not found", c.Format, name)
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 1 || !types.Identical(sig.Params().At(0).Type(), types.Typ[types.String]) ||
+		sig.Results().Len() != 1 || sig.Results().At(0).Type().String() != "error" {
+		return fmt.Errorf("constraint format %s: %s must have the signature func(string) error", c.Format, name)
+	}
+
+	c.FormatFunc = fn
+	return nil
+}
+
+// constrainedInputs returns the names of the input types having a field with a @constraint,
+// directly or through other inputs. Their unmarshal functions report every violation.
+func constrainedInputs(inputs Objects) map[string]bool {
+	constrained := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, input := range inputs {
+			if constrained[input.Name] {
+				continue
+			}
+			for _, field := range input.Fields {
+				if field.Constraint != nil ||
+					field.TypeReference != nil && constrained[field.TypeReference.Definition.Name] {
+					constrained[input.Name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return constrained
+}
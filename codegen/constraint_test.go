@@ -0,0 +1,58 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func TestBuildConstraint(t *testing.T) {
+	build := func(field string) (*Constraint, error) {
+		schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+			directive @constraint(minLength: Int, maxLength: Int, pattern: String, min: Float, max: Float, format: String) on INPUT_FIELD_DEFINITION
+			enum Role { ADMIN }
+			input Input { ` + field + ` }
+			type Query { f(input: Input): Int }
+		`})
+		require.NoError(t, err)
+		b := builder{Config: &config.Config{}, Schema: schema}
+		def := schema.Types["Input"].Fields[0]
+		return b.buildConstraint(def.Type, def.Directives)
+	}
+
+	c, err := build(`name: String @constraint(minLength: 1, maxLength: 5, pattern: "^[a-z]+$", format: "email")`)
+	require.NoError(t, err)
+	require.Equal(t, 1, *c.MinLength)
+	require.Equal(t, 5, *c.MaxLength)
+	require.Equal(t, "^[a-z]+$", c.Pattern)
+	require.Equal(t, "email", c.Format)
+
+	c, err = build(`scores: [Float!] @constraint(maxLength: 3, min: 0, max: 1.5)`)
+	require.NoError(t, err)
+	require.Equal(t, 3, *c.MaxLength)
+	require.InDelta(t, 0, *c.Min, 0)
+	require.InDelta(t, 1.5, *c.Max, 0)
+
+	c, err = build(`name: String`)
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	for field, expected := range map[string]string{
+		`age: Int @constraint(minLength: 1)`:                   "@constraint(minLength:) can only be used on strings and lists",
+		`name: String @constraint(min: 1)`:                     "@constraint(min:) can only be used on numbers",
+		`role: Role @constraint(max: 1)`:                       "@constraint(max:) can only be used on numbers",
+		`admin: Boolean @constraint(pattern: "a")`:             "@constraint(pattern:) can only be used on strings",
+		`name: String @constraint(pattern: "(")`:               "@constraint(pattern:) is not a valid regular expression: error parsing regexp: missing closing ): `(`",
+		`name: String @constraint(maxLength: -1)`:              "@constraint(maxLength:) must be a non negative integer",
+		`name: String @constraint(minLength: 2, maxLength: 1)`: "@constraint(minLength:) must not be greater than maxLength",
+		`age: Int @constraint(min: 2, max: 1)`:                 "@constraint(min:) must not be greater than max",
+		`name: String @constraint(format: "slug")`:             `unknown @constraint format "slug", expected one of date, date-time, email, ipv4, ipv6, url, uuid or a format registered in constraints.formats`,
+	} {
+		_, err := build(field)
+		require.EqualError(t, err, expected, field)
+	}
+}
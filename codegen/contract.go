@@ -0,0 +1,31 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/graphql/contract"
+)
+
+// checkContracts filters schema with every contract of the config, so a contract leaving an
+// invalid variant fails the generation rather than the server start.
+func checkContracts(schema *ast.Schema, contracts map[string]config.ContractConfig) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+	if schema.Directives["tag"] == nil {
+		return errors.New("contracts: the schema must declare the @tag(name: String!) directive")
+	}
+	for _, name := range slices.Sorted(maps.Keys(contracts)) {
+		c := contract.Contract{Include: contracts[name].Include, Exclude: contracts[name].Exclude}
+		if _, err := c.Filter(schema); err != nil {
+			return fmt.Errorf("contracts.%s: %w", name, err)
+		}
+	}
+	return nil
+}
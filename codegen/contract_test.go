@@ -0,0 +1,31 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func TestCheckContracts(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT
+		type Query { name: String, secret: String @tag(name: "internal") }
+	`})
+
+	require.NoError(t, checkContracts(schema, nil))
+	require.NoError(t, checkContracts(schema, map[string]config.ContractConfig{
+		"public": {Exclude: config.StringList{"internal"}},
+	}))
+	require.EqualError(t, checkContracts(schema, map[string]config.ContractConfig{
+		"public":  {Exclude: config.StringList{"internal"}},
+		"partner": {Include: config.StringList{"partner"}},
+	}), "contracts.partner: the contract removes every field of Query")
+
+	untagged := gqlparser.MustLoadSchema(&ast.Source{Input: `type Query { name: String }`})
+	require.EqualError(t, checkContracts(untagged, map[string]config.ContractConfig{"public": {}}),
+		"contracts: the schema must declare the @tag(name: String!) directive")
+}
@@ -3,8 +3,10 @@ package codegen
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -31,6 +33,9 @@ type Data struct {
 	Interfaces      map[string]*Interface
 	ReferencedTypes map[string]*config.TypeReference
 	ComplexityRoots map[string]*Object
+	// ConstrainedInputs are the inputs whose unmarshal functions report every @constraint
+	// violation rather than the first error.
+	ConstrainedInputs map[string]bool
 
 	QueryRoot        *Object
 	MutationRoot     *Object
@@ -121,6 +126,9 @@ func BuildData(cfg *config.Config, plugins ...any) (*Data, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkContracts(b.Schema, cfg.Contracts); err != nil {
+		return nil, err
+	}
 
 	dataDirectives := make(map[string]*Directive)
 	for name, d := range b.Directives {
@@ -137,7 +145,10 @@ func BuildData(cfg *config.Config, plugins ...any) (*Data, error) {
 		Plugins:       plugins,
 	}
 
-	for _, schemaType := range b.Schema.Types {
+	// building in name order makes the bound references, and with them the input hashes of
+	// incremental generation, the same between runs.
+	for _, name := range slices.Sorted(maps.Keys(b.Schema.Types)) {
+		schemaType := b.Schema.Types[name]
 		switch schemaType.Kind {
 		case ast.Object:
 			obj, err := b.buildObject(schemaType)
@@ -181,6 +192,7 @@ func BuildData(cfg *config.Config, plugins ...any) (*Data, error) {
 	}
 
 	s.ReferencedTypes = b.buildTypes()
+	s.ConstrainedInputs = constrainedInputs(s.Inputs)
 
 	sort.Slice(s.Objects, func(i, j int) bool {
 		return s.Objects[i].Name < s.Objects[j].Name
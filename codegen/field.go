@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"golang.org/x/text/cases"
@@ -35,6 +36,8 @@ type Field struct {
 	Default          any              // The default value
 	Stream           bool             // does this field return a channel?
 	Directives       []*Directive
+	Constraint       *Constraint   // The @constraint of an input field, if any
+	Timeout          time.Duration // The time the resolver has to return, 0 if it has no timeout
 }
 
 func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, error) {
@@ -52,6 +55,13 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		GoReceiverName:  "obj",
 	}
 
+	if obj.Kind == ast.InputObject {
+		f.Constraint, err = b.buildConstraint(field.Type, field.Directives)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+		}
+	}
+
 	if field.DefaultValue != nil {
 		var err error
 		f.Default, err = field.DefaultValue.Value(nil)
@@ -65,6 +75,10 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		if err != nil {
 			return nil, err
 		}
+		newArg.Constraint, err = b.buildConstraint(arg.Type, arg.Directives)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s(%s:): %w", obj.Name, field.Name, arg.Name, err)
+		}
 		f.Args = append(f.Args, newArg)
 	}
 
@@ -81,6 +95,19 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		f.TypeReference = b.Binder.PointerTo(f.TypeReference)
 	}
 
+	if obj.Kind != ast.InputObject && f.TypeReference != nil {
+		levels, err := semanticNonNullLevels(field.Type, field.Directives)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+		}
+		f.TypeReference.SemanticNonNullLevels = levels
+
+		f.Timeout, err = b.fieldTimeout(obj, &f)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+		}
+	}
+
 	return &f, nil
 }
 
@@ -509,6 +536,15 @@ func (f *Field) FieldContextFunc() string {
 	return "fieldContext_" + f.Object.Name + "_" + f.Name
 }
 
+// TimeoutExpr returns the Go expression of Timeout, in milliseconds unless it is not a whole
+// number of them.
+func (f *Field) TimeoutExpr() string {
+	if f.Timeout%time.Millisecond != 0 {
+		return fmt.Sprintf("%d * time.Nanosecond", f.Timeout.Nanoseconds())
+	}
+	return fmt.Sprintf("%d * time.Millisecond", f.Timeout.Milliseconds())
+}
+
 func (f *Field) ChildFieldContextFunc(name string) string {
 	return "fieldContext_" + f.TypeReference.Definition.Name + "_" + name
 }
@@ -7,6 +7,7 @@ import (
 	"go/token"
 	"go/types"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	ast2 "github.com/vektah/gqlparser/v2/ast"
@@ -208,3 +209,15 @@ func TestField_CallArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestField_TimeoutExpr(t *testing.T) {
+	for timeout, expr := range map[time.Duration]string{
+		20 * time.Millisecond:   "20 * time.Millisecond",
+		2 * time.Second:         "2000 * time.Millisecond",
+		500 * time.Microsecond:  "500000 * time.Nanosecond",
+		1500 * time.Microsecond: "1500000 * time.Nanosecond",
+	} {
+		f := Field{Timeout: timeout}
+		require.Equal(t, expr, f.TimeoutExpr(), timeout.String())
+	}
+}
@@ -80,7 +80,7 @@ func generatePerSchema(data *Data) error {
 		dir := data.Config.Exec.DirName
 		path := filepath.Join(dir, filename)
 
-		err = templates.Render(templates.Options{
+		err = renderIncremental(build, templates.Options{
 			PackageName:     data.Config.Exec.Package,
 			ImportPath:      data.Config.Exec.GetImportPath(),
 			Filename:        path,
@@ -121,11 +121,12 @@ func addBuild(filename string, p *ast.Position, data *Data, builds *map[string]*
 	}
 
 	(*builds)[filename] = &Data{
-		Config:           &buildConfig,
-		QueryRoot:        data.QueryRoot,
-		MutationRoot:     data.MutationRoot,
-		SubscriptionRoot: data.SubscriptionRoot,
-		AllDirectives:    data.AllDirectives,
+		Config:            &buildConfig,
+		QueryRoot:         data.QueryRoot,
+		MutationRoot:      data.MutationRoot,
+		SubscriptionRoot:  data.SubscriptionRoot,
+		AllDirectives:     data.AllDirectives,
+		ConstrainedInputs: data.ConstrainedInputs,
 	}
 }
 
@@ -138,7 +139,7 @@ func generateRootFile(data *Data) error {
 	dir := data.Config.Exec.DirName
 	path := filepath.Join(dir, "root_.generated.go")
 
-	return templates.Render(templates.Options{
+	return renderIncremental(data, templates.Options{
 		PackageName:     data.Config.Exec.Package,
 		ImportPath:      data.Config.Exec.GetImportPath(),
 		Template:        rootTemplate,
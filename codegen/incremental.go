@@ -0,0 +1,188 @@
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// renderIncremental renders opts, unless exec.incremental is set and the file was already written
+// from the same inputs.
+func renderIncremental(data *Data, opts templates.Options) error {
+	if !data.Config.Exec.Incremental {
+		return templates.Render(opts)
+	}
+
+	hash := inputHash(data)
+	if templates.ReadInputHash(opts.Filename) == hash {
+		return nil
+	}
+	opts.InputHash = hash
+	return templates.Render(opts)
+}
+
+// inputHash hashes everything the templates see when rendering data: the gqlgen version and
+// templates, the config, the schema sources and the objects, inputs and types built from them.
+//
+// Positions are left out, so editing one schema file only changes the hash of the files using a
+// definition that changed. Models are left out of the config, the Go types they bind to are part
+// of the built types.
+func inputHash(data *Data) string {
+	h := sha256.New()
+	h.Write(templatesHash())
+
+	hasher := &valueHasher{w: h, visiting: map[pointerKey]bool{}}
+
+	cfg := *data.Config
+	cfg.SchemaFilename = nil
+	cfg.Models = nil
+	cfg.SkipModTidy = false
+	cfg.SkipValidation = false
+	cfg.PackageLoader = nil
+	hasher.hash(reflect.ValueOf(cfg))
+
+	for _, src := range data.Config.Sources {
+		fmt.Fprintf(h, "%s\x00%s\x00", src.Name, src.Input)
+	}
+
+	// the per schema files only need the names of the root types, the root file gets them from
+	// the objects.
+	build := *data
+	build.Config, build.Schema, build.Plugins = nil, nil, nil
+	build.QueryRoot, build.MutationRoot, build.SubscriptionRoot = nil, nil, nil
+	for _, root := range []*Object{data.QueryRoot, data.MutationRoot, data.SubscriptionRoot} {
+		if root != nil {
+			fmt.Fprintf(h, "root %s\x00", root.Name)
+		}
+	}
+	hasher.hash(reflect.ValueOf(build))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+var templatesHash = sync.OnceValue(func() []byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00", graphql.Version, rootTemplate)
+	err := fs.WalkDir(codegenTemplates, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := fs.ReadFile(codegenTemplates, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%s\x00", path, b)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return h.Sum(nil)
+})
+
+type pointerKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// valueHasher writes a canonical form of a value graph, visiting maps in key order. Only exported fields are visited, as those are all templates can reach.
+type valueHasher struct {
+	w        io.Writer
+	visiting map[pointerKey]bool
+}
+
+var (
+	positionType = reflect.TypeOf(&ast.Position{})
+	sourceType   = reflect.TypeOf(&ast.Source{})
+	schemaType   = reflect.TypeOf(&ast.Schema{})
+	configType   = reflect.TypeOf(&config.Config{})
+	packagesType = reflect.TypeOf(&code.Packages{})
+)
+
+func (h *valueHasher) hash(v reflect.Value) {
+	if !v.IsValid() {
+		io.WriteString(h.w, "nil;")
+		return
+	}
+
+	switch v.Type() {
+	case positionType, sourceType, schemaType, configType, packagesType:
+		return
+	}
+
+	// go/types values have unexported fields only, their string form is what templates render.
+	if t := v.Type(); t.PkgPath() == "go/types" || t.Kind() == reflect.Pointer && t.Elem().PkgPath() == "go/types" {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			io.WriteString(h.w, "nil;")
+		} else {
+			fmt.Fprintf(h.w, "%v;", v)
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			io.WriteString(h.w, "nil;")
+			return
+		}
+		// the same value can be shared or copied depending on the order things were built in, so
+		// only cycles are cut short.
+		key := pointerKey{typ: v.Type(), ptr: v.Pointer()}
+		if h.visiting[key] {
+			io.WriteString(h.w, "cycle;")
+			return
+		}
+		h.visiting[key] = true
+		h.hash(v.Elem())
+		delete(h.visiting, key)
+	case reflect.Interface:
+		if v.IsNil() {
+			io.WriteString(h.w, "nil;")
+			return
+		}
+		fmt.Fprintf(h.w, "%s:", v.Elem().Type())
+		h.hash(v.Elem())
+	case reflect.Struct:
+		io.WriteString(h.w, "{")
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.IsExported() {
+				fmt.Fprintf(h.w, "%s:", f.Name)
+				h.hash(v.Field(i))
+			}
+		}
+		io.WriteString(h.w, "}")
+	case reflect.Slice, reflect.Array:
+		fmt.Fprintf(h.w, "[%d:", v.Len())
+		for i := 0; i < v.Len(); i++ {
+			h.hash(v.Index(i))
+		}
+		io.WriteString(h.w, "]")
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		fmt.Fprintf(h.w, "map[%d:", v.Len())
+		for _, k := range keys {
+			h.hash(k)
+			h.hash(v.MapIndex(k))
+		}
+		io.WriteString(h.w, "]")
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+	default:
+		fmt.Fprintf(h.w, "%v;", v)
+	}
+}
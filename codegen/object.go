@@ -35,6 +35,7 @@ type Object struct {
 	Stream                   bool
 	Directives               []*Directive
 	PointersInUnmarshalInput bool
+	InputDecoders            bool
 }
 
 func (b *builder) buildObject(typ *ast.Definition) (*Object, error) {
@@ -50,6 +51,7 @@ func (b *builder) buildObject(typ *ast.Definition) (*Object, error) {
 		Stream:                   typ == b.Schema.Subscription,
 		Directives:               dirs,
 		PointersInUnmarshalInput: b.Config.ReturnPointersInUnmarshalInput,
+		InputDecoders:            b.Config.Exec.InputDecoders,
 		ResolverInterface: types.NewNamed(
 			types.NewTypeName(0, b.Config.Exec.Pkg(), caser.String(typ.Name)+"Resolver", nil),
 			nil,
@@ -126,6 +128,12 @@ func (o *Object) HasUnmarshal() bool {
 	return false
 }
 
+// HasDecoder reports whether a decoder reading the input object from JSON is generated for it,
+// inputs with their own UnmarshalGQL or bound to maps are decoded to maps.
+func (o *Object) HasDecoder() bool {
+	return o.InputDecoders && !o.HasUnmarshal() && !o.IsMap()
+}
+
 func (o *Object) HasDirectives() bool {
 	if len(o.Directives) > 0 {
 		return true
@@ -0,0 +1,48 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// semanticNonNullLevels returns the list levels of a field of type typ marked with
+// @semanticNonNull, 0 being the field itself and 1 the items of its list. The values at these
+// levels are non-null for clients but their nulls, only caused by errors, do not bubble up.
+func semanticNonNullLevels(typ *ast.Type, directives ast.DirectiveList) ([]int, error) {
+	d := directives.ForName("semanticNonNull")
+	if d == nil {
+		return nil, nil
+	}
+
+	levels := []int{0}
+	if value, ok := d.ArgumentMap(nil)["levels"]; ok {
+		values, ok := value.([]any)
+		if !ok {
+			return nil, errors.New("@semanticNonNull(levels:) must be a list of integers")
+		}
+		levels = make([]int, 0, len(values))
+		for _, v := range values {
+			n, ok := v.(int64)
+			if !ok || n < 0 {
+				return nil, errors.New("@semanticNonNull(levels:) must be a list of non negative integers")
+			}
+			levels = append(levels, int(n))
+		}
+	}
+
+	depth := 0
+	for t := typ; t.Elem != nil; t = t.Elem {
+		depth++
+	}
+	for _, level := range levels {
+		if level > depth {
+			return nil, fmt.Errorf("@semanticNonNull(levels:) level %d is deeper than the type %s", level, typ)
+		}
+	}
+
+	slices.Sort(levels)
+	return slices.Compact(levels), nil
+}
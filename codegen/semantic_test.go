@@ -0,0 +1,39 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestSemanticNonNullLevels(t *testing.T) {
+	levels := func(field string) ([]int, error) {
+		schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+			directive @semanticNonNull(levels: [Int] = [0]) on FIELD_DEFINITION
+			type Query { ` + field + ` }
+		`})
+		require.NoError(t, err)
+		def := schema.Query.Fields[0]
+		return semanticNonNullLevels(def.Type, def.Directives)
+	}
+
+	l, err := levels(`name: String`)
+	require.NoError(t, err)
+	require.Nil(t, l)
+
+	l, err = levels(`name: String @semanticNonNull`)
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, l)
+
+	l, err = levels(`names: [[String]] @semanticNonNull(levels: [2, 0, 2])`)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 2}, l)
+
+	_, err = levels(`names: [String] @semanticNonNull(levels: [2])`)
+	require.EqualError(t, err, "@semanticNonNull(levels:) level 2 is deeper than the type [String]")
+
+	_, err = levels(`name: String @semanticNonNull(levels: [-1])`)
+	require.EqualError(t, err, "@semanticNonNull(levels:) must be a list of non negative integers")
+}
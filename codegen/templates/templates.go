@@ -1,6 +1,7 @@
 package templates
 
 import (
+	"bufio"
 	"bytes"
 	"errors"
 	"fmt"
@@ -62,6 +63,10 @@ type Options struct {
 
 	// Packages cache, you can find me on config.Config
 	Packages *code.Packages
+
+	// InputHash is recorded in the header of the generated file, ReadInputHash returns it. It lets
+	// generators skip files that were already written from the same inputs.
+	InputHash string
 }
 
 var (
@@ -144,7 +149,13 @@ func defaultRender(cfg Options) error {
 
 	var result bytes.Buffer
 	if cfg.GeneratedHeader {
-		result.WriteString("// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\n")
+		result.WriteString("// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n")
+	}
+	if cfg.InputHash != "" {
+		result.WriteString(inputHashPrefix + cfg.InputHash + "\n")
+	}
+	if result.Len() > 0 {
+		result.WriteString("\n")
 	}
 	if cfg.PackageDoc != "" {
 		result.WriteString(cfg.PackageDoc + "\n")
@@ -174,6 +185,30 @@ func defaultRender(cfg Options) error {
 	return nil
 }
 
+const inputHashPrefix = "// gqlgen input hash: "
+
+// ReadInputHash returns the input hash recorded in the header of a file written by Render, or an
+// empty string if the file does not exist or has none.
+func ReadInputHash(filename string) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, inputHashPrefix) {
+			return strings.TrimPrefix(line, inputHashPrefix)
+		}
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	return ""
+}
+
 func parseTemplates(cfg Options, t *template.Template) (*template.Template, error) {
 	if cfg.Template != "" {
 		var err error
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -433,6 +434,31 @@ func TestRenderFS(t *testing.T) {
 	assert.Equal(t, expectedString, actualContentsStr[:len(expectedString)])
 }
 
+func TestInputHash(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "gqlgen.go")
+	require.Empty(t, ReadInputHash(filename))
+
+	err := Render(Options{
+		Template:        "var x = 1",
+		Filename:        filename,
+		PackageName:     "out",
+		GeneratedHeader: true,
+		InputHash:       "abc123",
+		Packages:        code.NewPackages(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "abc123", ReadInputHash(filename))
+
+	b, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(b),
+		"// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n// gqlgen input hash: abc123\n\npackage out\n"))
+
+	err = Render(Options{Template: "var x = 1", Filename: filename, PackageName: "out", Packages: code.NewPackages()})
+	require.NoError(t, err)
+	require.Empty(t, ReadInputHash(filename))
+}
+
 func TestDict(t *testing.T) {
 	tests := []struct {
 		name      string
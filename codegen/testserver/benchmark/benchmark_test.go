@@ -1,15 +1,26 @@
 //go:generate go run ../../../testdata/gqlgen.go -config gqlgen.yml -stub stub.go
+//go:generate go run ../../../testdata/gqlgen.go -config gqlgen_decoders.yml
 
 package benchmark
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/99designs/gqlgen/codegen/testserver/benchmark/generated"
+	"github.com/99designs/gqlgen/codegen/testserver/benchmark/generated/decoders"
 	"github.com/99designs/gqlgen/codegen/testserver/benchmark/generated/models"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
 
 func BenchmarkResolvers(b *testing.B) {
@@ -172,3 +183,206 @@ query GetUsersConnection($query: String, $first: Int, $before: String, $orderBy:
 		})
 	}
 }
+
+// decodersStub serves the Stub from the schema generated with input decoders.
+type decodersStub struct{ *Stub }
+
+func (r decodersStub) Mutation() decoders.MutationResolver { return &stubMutation{r.Stub} }
+func (r decodersStub) Query() decoders.QueryResolver       { return &stubQuery{r.Stub} }
+
+func createUsersServers(created *[]*models.UserInput) map[string]http.Handler {
+	resolvers := &Stub{}
+	resolvers.MutationResolver.CreateUsers = func(ctx context.Context, input []*models.UserInput) (int, error) {
+		*created = input
+		return len(input), nil
+	}
+
+	servers := map[string]http.Handler{}
+	for name, es := range map[string]graphql.ExecutableSchema{
+		"maps":     generated.NewExecutableSchema(generated.Config{Resolvers: resolvers}),
+		"decoders": decoders.NewExecutableSchema(decoders.Config{Resolvers: decodersStub{resolvers}}),
+	} {
+		srv := handler.New(es)
+		srv.AddTransport(transport.POST{})
+		servers[name] = srv
+	}
+	return servers
+}
+
+func createUsersBody(users int) string {
+	input := make([]map[string]any, users)
+	for i := range input {
+		input[i] = map[string]any{
+			"firstName": fmt.Sprintf("John %d", i),
+			"lastName":  "Doe",
+			"email":     "johndoe@acme.inc",
+			"tags":      []string{"admin", "staff", "remote"},
+			"addresses": []map[string]any{
+				{"street": "Main Street 1", "city": "Amsterdam", "zip": "1011AB"},
+				{"street": "Side Street 2", "city": "Utrecht", "country": "BE"},
+			},
+		}
+	}
+	body, err := json.Marshal(map[string]any{
+		"query":     `mutation CreateUsers($input: [UserInput!]!) { createUsers(input: $input) }`,
+		"variables": map[string]any{"input": input},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return string(body)
+}
+
+func post(srv http.Handler, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+	return w
+}
+
+func TestInputDecoders(t *testing.T) {
+	var created []*models.UserInput
+	servers := createUsersServers(&created)
+
+	t.Run("decode the same inputs as maps", func(t *testing.T) {
+		body := createUsersBody(3)
+		w := post(servers["maps"], body)
+		require.JSONEq(t, `{"data":{"createUsers":3}}`, w.Body.String())
+		fromMaps := created
+
+		w = post(servers["decoders"], body)
+		require.JSONEq(t, `{"data":{"createUsers":3}}`, w.Body.String())
+		require.Equal(t, fromMaps, created)
+		require.Equal(t, "NL", created[0].Addresses[0].Country)
+		require.Equal(t, "BE", created[0].Addresses[1].Country)
+	})
+
+	t.Run("keep the variables as maps", func(t *testing.T) {
+		resolvers := &Stub{}
+		resolvers.MutationResolver.CreateUsers = func(ctx context.Context, input []*models.UserInput) (int, error) {
+			user := graphql.GetOperationContext(ctx).Variables["input"].([]any)[0]
+			require.IsType(t, map[string]any{}, user)
+			require.NotNil(t, graphql.DecodedInputFor(ctx, user), "the input is read by its decoder")
+			return len(input), nil
+		}
+		srv := handler.New(decoders.NewExecutableSchema(decoders.Config{Resolvers: decodersStub{resolvers}}))
+		srv.AddTransport(transport.POST{})
+
+		w := post(srv, createUsersBody(1))
+		require.JSONEq(t, `{"data":{"createUsers":1}}`, w.Body.String())
+	})
+
+	for _, tc := range []struct {
+		name      string
+		variables string
+		message   string
+		path      string
+	}{
+		{
+			name:      "missing field",
+			variables: `{"input":[{"firstName":"John","lastName":"Doe"}]}`,
+			message:   "must be defined",
+			path:      `["variable","input",0,"email"]`,
+		},
+		{
+			name:      "unknown field",
+			variables: `{"input":{"firstName":"John","lastName":"Doe","email":"a","age":3}}`,
+			message:   "unknown field",
+			path:      `["variable","input",0,"age"]`,
+		},
+		{
+			name:      "wrong scalar",
+			variables: `{"input":[{"firstName":"John","lastName":"Doe","email":"a","tags":[true]}]}`,
+			message:   "cannot use bool as String",
+			path:      `["variable","input",0,"tags",0]`,
+		},
+		{
+			name:      "null in non null list",
+			variables: `{"input":[null]}`,
+			message:   "cannot be null",
+			path:      `["variable","input",0]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			body := `{"query":"mutation($input: [UserInput!]!) { createUsers(input: $input) }","variables":` + tc.variables + `}`
+			for name, srv := range servers {
+				w := post(srv, body)
+				require.Equal(t, http.StatusUnprocessableEntity, w.Code, name)
+				require.Contains(t, w.Body.String(), tc.message, name)
+				require.Contains(t, w.Body.String(), `"path":`+tc.path, name)
+			}
+		})
+	}
+}
+
+func BenchmarkInputDecoders(b *testing.B) {
+	var created []*models.UserInput
+	servers := createUsersServers(&created)
+
+	for _, users := range []int{1, 10, 100} {
+		body := createUsersBody(users)
+		for _, name := range []string{"maps", "decoders"} {
+			b.Run(fmt.Sprintf("%s_%d_users", name, users), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if w := post(servers[name], body); w.Code != http.StatusOK {
+						b.Fatal(w.Body.String())
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkResponses(b *testing.B) {
+	benchmarkResponses(b, "{ users { edges { cursor node { firstName lastName email } } pageInfo { hasNextPage startCursor } totalCount } }")
+}
+
+// BenchmarkScalarResponses selects the Int, Float and ID fields of the users, which are formatted
+// while the response is written instead of copied like strings.
+func BenchmarkScalarResponses(b *testing.B) {
+	benchmarkResponses(b, "{ users { edges { node { id age rating } } totalCount } }")
+}
+
+func benchmarkResponses(b *testing.B, query string) {
+	for _, users := range []int{1, 100, 1000} {
+		connection := &models.UserConnection{PageInfo: &models.PageInfo{StartCursor: "0"}, TotalCount: users}
+		for i := 0; i < users; i++ {
+			connection.Edges = append(connection.Edges, &models.UserEdge{
+				Cursor: fmt.Sprint(i),
+				Node: &models.User{
+					ID:        1000000 + i,
+					Age:       20 + i%50,
+					Rating:    float64(i) / 7,
+					FirstName: "John",
+					LastName:  fmt.Sprintf("Doe %d", i),
+					Email:     "johndoe@acme.inc",
+				},
+			})
+		}
+		resolvers := &Stub{}
+		resolvers.QueryResolver.Users = func(ctx context.Context, query *string, first *int, last *int, before *string, after *string, orderBy models.UserOrderBy) (*models.UserConnection, error) {
+			return connection, nil
+		}
+		body := fmt.Sprintf(`{"query":%q}`, query)
+
+		for _, streamed := range []bool{false, true} {
+			srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolvers}))
+			srv.AddTransport(transport.POST{StreamResponses: streamed})
+			name := "buffered"
+			if streamed {
+				name = "streamed"
+			}
+
+			b.Run(fmt.Sprintf("%s_%d_users", name, users), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if w := post(srv, body); w.Code != http.StatusOK {
+						b.Fatal(w.Body.String())
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/codegen/testserver/benchmark/generated/decoders"
+	"github.com/99designs/gqlgen/codegen/testserver/benchmark/generated/models"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/handler/usage"
+)
+
+// The extensions reading the variables of operations see the same maps with the generated input
+// decoders as without them.
+func TestDecodedVariablesInExtensions(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.MutationResolver.CreateUsers = func(ctx context.Context, input []*models.UserInput) (int, error) {
+		return len(input), nil
+	}
+	resolvers.QueryResolver.Users = func(ctx context.Context, query *string, first *int, last *int, before *string, after *string, orderBy models.UserOrderBy) (*models.UserConnection, error) {
+		return &models.UserConnection{}, nil
+	}
+	newServer := func(extensions ...graphql.HandlerExtension) *handler.Server {
+		srv := handler.New(decoders.NewExecutableSchema(decoders.Config{Resolvers: decodersStub{resolvers}}))
+		srv.AddTransport(transport.POST{})
+		for _, extension := range extensions {
+			srv.Use(extension)
+		}
+		return srv
+	}
+	body := `{
+		"query": "mutation($input: [UserInput!]!) { createUsers(input: $input) }",
+		"variables": {"input": [{
+			"firstName": "John",
+			"lastName": "Doe",
+			"email": "johndoe@acme.inc",
+			"addresses": [{"street": "Main Street 1", "city": "Amsterdam", "zip": "1011AB"}]
+		}]}
+	}`
+
+	t.Run("logger redacts decoded inputs", func(t *testing.T) {
+		var buf bytes.Buffer
+		srv := newServer(&extension.Logger{
+			Log:      slog.New(slog.NewJSONHandler(&buf, nil)),
+			Redactor: extension.Redactor{Patterns: []string{"**.zip"}},
+		})
+
+		w := post(srv, body)
+		require.JSONEq(t, `{"data":{"createUsers":1}}`, w.Body.String())
+
+		var entry struct {
+			Variables map[string]any `json:"variables"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		require.Equal(t, map[string]any{"input": []any{map[string]any{
+			"firstName": "John",
+			"lastName":  "Doe",
+			"email":     "[REDACTED]",
+			"addresses": []any{map[string]any{
+				"street": "Main Street 1",
+				"city":   "Amsterdam",
+				"zip":    "[REDACTED]",
+			}},
+		}}}, entry.Variables)
+	})
+
+	t.Run("usage tracker walks decoded inputs", func(t *testing.T) {
+		sink := usage.NewMemorySink()
+		srv := newServer(&usage.Tracker{Sink: sink})
+
+		w := post(srv, body)
+		require.JSONEq(t, `{"data":{"createUsers":1}}`, w.Body.String())
+		w = post(srv, `{
+			"query": "query($orderBy: UserOrderBy!) { users(orderBy: $orderBy) { totalCount } }",
+			"variables": {"orderBy": {"orderByField": "EMAIL", "orderByDirection": "DESCENDING"}}
+		}`)
+		require.NotContains(t, w.Body.String(), "errors")
+
+		coordinates := slices.Sorted(maps.Keys(sink.Usage()[0].Coordinates))
+		require.Equal(t, []string{
+			"AddressInput.city",
+			"AddressInput.street",
+			"AddressInput.zip",
+			"Mutation.createUsers",
+			"Mutation.createUsers(input:)",
+			"OrderDirection.DESCENDING",
+			"Query.users",
+			"Query.users(orderBy:)",
+			"UserConnection.totalCount",
+			"UserInput.addresses",
+			"UserInput.email",
+			"UserInput.firstName",
+			"UserInput.lastName",
+			"UserOrderBy.orderByDirection",
+			"UserOrderBy.orderByField",
+			"UserOrderField.EMAIL",
+		}, coordinates)
+	})
+
+	t.Run("lookahead arguments hold the maps of decoded inputs", func(t *testing.T) {
+		var args map[string]any
+		resolvers.MutationResolver.CreateUsers = func(ctx context.Context, input []*models.UserInput) (int, error) {
+			args = graphql.Lookahead(ctx).Arguments
+			return len(input), nil
+		}
+		srv := newServer()
+
+		w := post(srv, body)
+		require.JSONEq(t, `{"data":{"createUsers":1}}`, w.Body.String())
+		require.Equal(t, map[string]any{"input": []any{map[string]any{
+			"firstName": "John",
+			"lastName":  "Doe",
+			"email":     "johndoe@acme.inc",
+			"addresses": []any{map[string]any{
+				"street": "Main Street 1",
+				"city":   "Amsterdam",
+				"zip":    "1011AB",
+			}},
+		}}}, args)
+	})
+}
@@ -9,6 +9,16 @@ import (
 	"strconv"
 )
 
+type AddressInput struct {
+	Street  string  `json:"street"`
+	City    string  `json:"city"`
+	Zip     *string `json:"zip,omitempty"`
+	Country string  `json:"country"`
+}
+
+type Mutation struct {
+}
+
 type PageInfo struct {
 	HasNextPage     bool   `json:"hasNextPage"`
 	HasPreviousPage bool   `json:"hasPreviousPage"`
@@ -20,9 +30,12 @@ type Query struct {
 }
 
 type User struct {
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
+	ID        int     `json:"id"`
+	Age       int     `json:"age"`
+	Rating    float64 `json:"rating"`
+	FirstName string  `json:"firstName"`
+	LastName  string  `json:"lastName"`
+	Email     string  `json:"email"`
 }
 
 type UserConnection struct {
@@ -36,6 +49,14 @@ type UserEdge struct {
 	Node   *User  `json:"node"`
 }
 
+type UserInput struct {
+	FirstName string          `json:"firstName"`
+	LastName  string          `json:"lastName"`
+	Email     string          `json:"email"`
+	Tags      []string        `json:"tags,omitempty"`
+	Addresses []*AddressInput `json:"addresses,omitempty"`
+}
+
 type UserOrderBy struct {
 	OrderByField     UserOrderField `json:"orderByField"`
 	OrderByDirection OrderDirection `json:"orderByDirection"`
@@ -11,14 +11,23 @@ import (
 
 type Resolver struct{}
 
+// CreateUsers is the resolver for the createUsers field.
+func (r *mutationResolver) CreateUsers(ctx context.Context, input []*models.UserInput) (int, error) {
+	panic("not implemented")
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, query *string, first *int, last *int, before *string, after *string, orderBy models.UserOrderBy) (*models.UserConnection, error) {
 	panic("not implemented")
 }
 
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 
 // !!! WARNING !!!
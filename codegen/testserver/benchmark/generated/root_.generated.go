@@ -3,7 +3,6 @@
 package generated
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"sync/atomic"
@@ -33,6 +32,7 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Mutation() MutationResolver
 	Query() QueryResolver
 }
 
@@ -40,6 +40,10 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	Mutation struct {
+		CreateUsers func(childComplexity int, input []*models.UserInput) int
+	}
+
 	PageInfo struct {
 		EndCursor       func(childComplexity int) int
 		HasNextPage     func(childComplexity int) int
@@ -52,9 +56,12 @@ type ComplexityRoot struct {
 	}
 
 	User struct {
+		Age       func(childComplexity int) int
 		Email     func(childComplexity int) int
 		FirstName func(childComplexity int) int
+		ID        func(childComplexity int) int
 		LastName  func(childComplexity int) int
+		Rating    func(childComplexity int) int
 	}
 
 	UserConnection struct {
@@ -88,6 +95,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Mutation.createUsers":
+		if e.complexity.Mutation.CreateUsers == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createUsers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateUsers(childComplexity, args["input"].([]*models.UserInput)), true
+
 	case "PageInfo.endCursor":
 		if e.complexity.PageInfo.EndCursor == nil {
 			break
@@ -128,6 +147,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Users(childComplexity, args["query"].(*string), args["first"].(*int), args["last"].(*int), args["before"].(*string), args["after"].(*string), args["orderBy"].(models.UserOrderBy)), true
 
+	case "User.age":
+		if e.complexity.User.Age == nil {
+			break
+		}
+
+		return e.complexity.User.Age(childComplexity), true
+
 	case "User.email":
 		if e.complexity.User.Email == nil {
 			break
@@ -142,6 +168,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.User.FirstName(childComplexity), true
 
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
 	case "User.lastName":
 		if e.complexity.User.LastName == nil {
 			break
@@ -149,6 +182,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.User.LastName(childComplexity), true
 
+	case "User.rating":
+		if e.complexity.User.Rating == nil {
+			break
+		}
+
+		return e.complexity.User.Rating(childComplexity), true
+
 	case "UserConnection.edges":
 		if e.complexity.UserConnection.Edges == nil {
 			break
@@ -188,10 +228,50 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	return 0, false
 }
 
+// GoFieldName implements graphql.GoFieldMapper, it returns the Go struct field the field of an
+// object type is bound to.
+func (e *executableSchema) GoFieldName(typeName, field string) (string, bool) {
+	switch typeName + "." + field {
+	case "PageInfo.hasNextPage":
+		return "HasNextPage", true
+	case "PageInfo.hasPreviousPage":
+		return "HasPreviousPage", true
+	case "PageInfo.startCursor":
+		return "StartCursor", true
+	case "PageInfo.endCursor":
+		return "EndCursor", true
+	case "User.id":
+		return "ID", true
+	case "User.age":
+		return "Age", true
+	case "User.rating":
+		return "Rating", true
+	case "User.firstName":
+		return "FirstName", true
+	case "User.lastName":
+		return "LastName", true
+	case "User.email":
+		return "Email", true
+	case "UserConnection.edges":
+		return "Edges", true
+	case "UserConnection.pageInfo":
+		return "PageInfo", true
+	case "UserConnection.totalCount":
+		return "TotalCount", true
+	case "UserEdge.cursor":
+		return "Cursor", true
+	case "UserEdge.node":
+		return "Node", true
+	}
+	return "", false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAddressInput,
+		ec.unmarshalInputUserInput,
 		ec.unmarshalInputUserOrderBy,
 	)
 	first := true
@@ -217,9 +297,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 					return nil
 				}
 			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
+			response.SetData(ctx, data)
 			if atomic.LoadInt32(&ec.deferred) > 0 {
 				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
 				response.HasNext = &hasNext
@@ -227,6 +305,18 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 
 			return &response
 		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var response graphql.Response
+			response.SetData(ctx, data)
+			return &response
+		}
 
 	default:
 		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
@@ -253,7 +343,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -264,14 +354,22 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 var sources = []*ast.Source{
@@ -299,6 +397,9 @@ input UserOrderBy {
 }
 
 type User {
+    id: ID!
+    age: Int!
+    rating: Float!
     firstName: String!
     lastName: String!
     email: String!
@@ -325,6 +426,27 @@ type Query {
         orderBy: UserOrderBy! = { orderByField: FIRST_NAME orderByDirection: ASCENDING }
     ): UserConnection
 }
+
+directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
+input UserInput {
+    firstName: String!
+    lastName: String!
+    email: String! @sensitive
+    tags: [String!]
+    addresses: [AddressInput!]
+}
+
+input AddressInput {
+    street: String!
+    city: String!
+    zip: String
+    country: String! = "NL"
+}
+
+type Mutation {
+    createUsers(input: [UserInput!]!): Int!
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -10,15 +10,27 @@ import (
 )
 
 type Stub struct {
+	MutationResolver struct {
+		CreateUsers func(ctx context.Context, input []*models.UserInput) (int, error)
+	}
 	QueryResolver struct {
 		Users func(ctx context.Context, query *string, first *int, last *int, before *string, after *string, orderBy models.UserOrderBy) (*models.UserConnection, error)
 	}
 }
 
+func (r *Stub) Mutation() generated.MutationResolver {
+	return &stubMutation{r}
+}
 func (r *Stub) Query() generated.QueryResolver {
 	return &stubQuery{r}
 }
 
+type stubMutation struct{ *Stub }
+
+func (r *stubMutation) CreateUsers(ctx context.Context, input []*models.UserInput) (int, error) {
+	return r.MutationResolver.CreateUsers(ctx, input)
+}
+
 type stubQuery struct{ *Stub }
 
 func (r *stubQuery) Users(ctx context.Context, query *string, first *int, last *int, before *string, after *string, orderBy models.UserOrderBy) (*models.UserConnection, error) {
@@ -269,7 +269,7 @@ func (ec *executionContext) _OverlappingFields(ctx context.Context, sel ast.Sele
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -0,0 +1,232 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package followschema
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+var constraint_ConstrainedAddressInput_zip = &graphql.Constraint{
+	Pattern: regexp.MustCompile("^[0-9]{5}$"),
+}
+
+func (ec *executionContext) unmarshalInputConstrainedAddressInput(ctx context.Context, obj any) (ConstrainedAddressInput, error) {
+	var it ConstrainedAddressInput
+	var errs gqlerror.List
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"zip"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "zip":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("zip"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			if violations := constraint_ConstrainedAddressInput_zip.Check(ctx, data); violations != nil {
+				errs = append(errs, violations...)
+				continue
+			}
+			it.Zip = data
+		}
+	}
+	if errs != nil {
+		return it, errs
+	}
+
+	return it, nil
+}
+
+var constraint_ConstrainedUserInput_name = &graphql.Constraint{
+	MinLength: graphql.Ptr(2),
+	MaxLength: graphql.Ptr(10),
+}
+var constraint_ConstrainedUserInput_email = &graphql.Constraint{
+	Format: "email",
+}
+var constraint_ConstrainedUserInput_age = &graphql.Constraint{
+	Min: graphql.Ptr[float64](0),
+	Max: graphql.Ptr[float64](150),
+}
+var constraint_ConstrainedUserInput_tags = &graphql.Constraint{
+	MaxLength: graphql.Ptr(2),
+	Pattern:   regexp.MustCompile("^[a-z]+$"),
+}
+var constraint_ConstrainedUserInput_handle = &graphql.Constraint{
+	Format:     "slug",
+	FormatFunc: ValidateSlug,
+}
+
+func (ec *executionContext) unmarshalInputConstrainedUserInput(ctx context.Context, obj any) (ConstrainedUserInput, error) {
+	var it ConstrainedUserInput
+	var errs gqlerror.List
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "email", "age", "tags", "handle", "address"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			if violations := constraint_ConstrainedUserInput_name.Check(ctx, data); violations != nil {
+				errs = append(errs, violations...)
+				continue
+			}
+			it.Name = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			if violations := constraint_ConstrainedUserInput_email.Check(ctx, data); violations != nil {
+				errs = append(errs, violations...)
+				continue
+			}
+			it.Email = data
+		case "age":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("age"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			if violations := constraint_ConstrainedUserInput_age.Check(ctx, data); violations != nil {
+				errs = append(errs, violations...)
+				continue
+			}
+			it.Age = data
+		case "tags":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			if violations := constraint_ConstrainedUserInput_tags.Check(ctx, data); violations != nil {
+				errs = append(errs, violations...)
+				continue
+			}
+			it.Tags = data
+		case "handle":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("handle"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			if violations := constraint_ConstrainedUserInput_handle.Check(ctx, data); violations != nil {
+				errs = append(errs, violations...)
+				continue
+			}
+			it.Handle = data
+		case "address":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			data, err := ec.unmarshalOConstrainedAddressInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedAddressInput(ctx, v)
+			if err != nil {
+				errs = graphql.AppendErrors(ctx, errs, err)
+				continue
+			}
+			it.Address = data
+		}
+	}
+	if errs != nil {
+		return it, errs
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNConstrainedUserInput2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedUserInput(ctx context.Context, v any) (ConstrainedUserInput, error) {
+	res, err := ec.unmarshalInputConstrainedUserInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNConstrainedUserInput2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedUserInputᚄ(ctx context.Context, v any) ([]*ConstrainedUserInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	var errs gqlerror.List
+	res := make([]*ConstrainedUserInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNConstrainedUserInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedUserInput(ctx, vSlice[i])
+		if err != nil {
+			errs = graphql.AppendErrors(ctx, errs, err)
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNConstrainedUserInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedUserInput(ctx context.Context, v any) (*ConstrainedUserInput, error) {
+	res, err := ec.unmarshalInputConstrainedUserInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOConstrainedAddressInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedAddressInput(ctx context.Context, v any) (*ConstrainedAddressInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputConstrainedAddressInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+// endregion ***************************** type.gotpl *****************************
@@ -0,0 +1,16 @@
+package followschema
+
+import (
+	"errors"
+	"regexp"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ValidateSlug is the slug format of @constraint.
+func ValidateSlug(s string) error {
+	if !slugPattern.MatchString(s) {
+		return errors.New("must be a slug")
+	}
+	return nil
+}
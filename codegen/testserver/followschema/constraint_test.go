@@ -0,0 +1,88 @@
+package followschema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestConstraint(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.ConstrainedUser = func(ctx context.Context, input ConstrainedUserInput) (bool, error) {
+		return true, nil
+	}
+	resolvers.QueryResolver.ConstrainedUsers = func(ctx context.Context, inputs []*ConstrainedUserInput) (bool, error) {
+		return true, nil
+	}
+	resolvers.QueryResolver.ConstrainedSearch = func(ctx context.Context, term string, limit *int) (bool, error) {
+		return true, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	type violation struct {
+		Message    string
+		Path       []any
+		Extensions map[string]any
+	}
+	violations := func(t *testing.T, query string) []violation {
+		resp, err := c.RawPost(query)
+		require.NoError(t, err)
+		var errs []violation
+		if resp.Errors != nil {
+			require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		}
+		return errs
+	}
+
+	t.Run("valid input", func(t *testing.T) {
+		require.Empty(t, violations(t, `{ constrainedUser(input: {
+			name: "Ada", email: "ada@example.com", age: 36, tags: ["math"], handle: "ada-l", address: { zip: "12345" }
+		}) }`))
+	})
+
+	t.Run("reports every violation of an input", func(t *testing.T) {
+		require.Equal(t, []violation{
+			{"must be at least 2 characters long", []any{"constrainedUser", "input", "name"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "minLength"}},
+			{"must be a valid email address", []any{"constrainedUser", "input", "email"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "format"}},
+			{"must be at most 150", []any{"constrainedUser", "input", "age"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "max"}},
+			{"must contain at most 2 items", []any{"constrainedUser", "input", "tags"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "maxLength"}},
+			{"must match ^[a-z]+$", []any{"constrainedUser", "input", "tags", float64(1)}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "pattern"}},
+			{"must be a slug", []any{"constrainedUser", "input", "handle"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "format"}},
+			{"must match ^[0-9]{5}$", []any{"constrainedUser", "input", "address", "zip"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "pattern"}},
+		}, violations(t, `{ constrainedUser(input: {
+			name: "A", email: "ada", age: 200, tags: ["math", "Logic", "art"], handle: "Ada L", address: { zip: "1234" }
+		}) }`))
+	})
+
+	t.Run("reports the violations of every list item", func(t *testing.T) {
+		var paths [][]any
+		for _, v := range violations(t, `{ constrainedUsers(inputs: [
+			{ name: "A", email: "ada@example.com" },
+			{ name: "Ada", email: "ada@example.com" },
+			{ name: "Ada", email: "ada" },
+		]) }`) {
+			paths = append(paths, v.Path)
+		}
+		require.Equal(t, [][]any{
+			{"constrainedUsers", "inputs", float64(0), "name"},
+			{"constrainedUsers", "inputs", float64(2), "email"},
+		}, paths)
+	})
+
+	t.Run("checks arguments", func(t *testing.T) {
+		require.Empty(t, violations(t, `{ constrainedSearch(term: "ada") }`))
+		require.Equal(t, []violation{
+			{"must be at least 3 characters long", []any{"constrainedSearch", "term"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "minLength"}},
+			{"must be at least 1", []any{"constrainedSearch", "limit"}, map[string]any{"code": "CONSTRAINT_VIOLATION", "constraint": "min"}},
+		}, violations(t, `{ constrainedSearch(term: "a", limit: 0) }`))
+	})
+}
@@ -0,0 +1,114 @@
+package followschema
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestExtendedScalars(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.ExtendedScalars = func(ctx context.Context, input ExtendedScalarsInput) (*ExtendedScalars, error) {
+		return &ExtendedScalars{
+			LocalTime: input.LocalTime,
+			DateTime:  input.DateTime,
+			BigInt:    new(big.Int).Mul(input.BigInt, big.NewInt(2)),
+			Decimal:   new(big.Rat).Mul(input.Decimal, big.NewRat(1, 3)),
+			JSON:      input.JSON,
+			URL:       input.URL.JoinPath("b"),
+			Email:     input.Email,
+			Count:     input.Count,
+			Size:      input.Size,
+			Ratio:     input.Ratio,
+		}, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("round trip", func(t *testing.T) {
+		var resp struct {
+			ExtendedScalars map[string]any
+		}
+		err := c.Post(`query($input: ExtendedScalarsInput!) {
+			extendedScalars(input: $input) { localTime dateTime bigInt decimal json url email count size ratio }
+		}`, &resp, client.Var("input", map[string]any{
+			"localTime": "10:15:30.5",
+			"dateTime":  "2024-03-01T10:20:30+02:00",
+			"bigInt":    "9007199254740993",
+			"decimal":   "1.5",
+			"json":      map[string]any{"a": []any{1, "b"}},
+			"url":       "https://example.com/a",
+			"email":     "gopher@example.com",
+			"count":     0,
+			"ratio":     0.5,
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"localTime": "10:15:30.500",
+			"dateTime":  "2024-03-01T10:20:30+02:00",
+			"bigInt":    "18014398509481986",
+			"decimal":   "0.5",
+			"json":      map[string]any{"a": []any{float64(1), "b"}},
+			"url":       "https://example.com/a/b",
+			"email":     "gopher@example.com",
+			"count":     float64(0),
+			"size":      nil,
+			"ratio":     0.5,
+		}, resp.ExtendedScalars)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for field, value := range map[string]any{
+			"localTime": "10:15",
+			"dateTime":  "2024-03-01T10:20:30",
+			"bigInt":    "1.5",
+			"decimal":   "1/3",
+			"url":       "/a",
+			"email":     "Gopher <gopher@example.com>",
+			"count":     -1,
+			"size":      0,
+			"ratio":     -0.5,
+		} {
+			input := map[string]any{
+				"localTime": "10:15:00",
+				"bigInt":    1,
+				"decimal":   "1",
+				"json":      1,
+				"url":       "https://example.com",
+				"email":     "gopher@example.com",
+				"count":     1,
+				"ratio":     1,
+			}
+			input[field] = value
+
+			var resp struct{}
+			err := c.Post(`query($input: ExtendedScalarsInput!) { extendedScalars(input: $input) { count } }`, &resp, client.Var("input", input))
+			require.ErrorContains(t, err, `"path":["extendedScalars","input","`+field+`"]`, field)
+		}
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		resolvers.QueryResolver.ExtendedScalars = func(ctx context.Context, input ExtendedScalarsInput) (*ExtendedScalars, error) {
+			return &ExtendedScalars{BigInt: big.NewInt(1), Decimal: big.NewRat(1, 1), URL: &url.URL{Path: "/a"}, Email: "gopher@example.com"}, nil
+		}
+
+		var resp struct {
+			ExtendedScalars struct{ URL *string }
+		}
+		err := c.Post(`query {
+			extendedScalars(input: {localTime: "10:15:00", bigInt: 1, decimal: "1", json: 1, url: "https://example.com", email: "gopher@example.com", count: 1, ratio: 1}) { url }
+		}`, &resp)
+		require.ErrorContains(t, err, `\"/a\" is not an absolute URL`)
+	})
+}
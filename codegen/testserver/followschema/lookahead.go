@@ -0,0 +1,16 @@
+package followschema
+
+type LookaheadUser struct {
+	ID      string
+	Name    string
+	Address LookaheadAddress
+}
+
+func (LookaheadUser) IsLookaheadResult() {}
+
+type LookaheadAddress struct {
+	City   string
+	Street string
+}
+
+func (LookaheadAddress) IsLookaheadResult() {}
@@ -357,7 +357,7 @@ func (ec *executionContext) _MapNested(ctx context.Context, sel ast.SelectionSet
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -399,7 +399,7 @@ func (ec *executionContext) _MapStringInterfaceType(ctx context.Context, sel ast
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
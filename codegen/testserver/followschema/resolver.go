@@ -52,6 +52,11 @@ func (r *forcedResolverResolver) Field(ctx context.Context, obj *ForcedResolver)
 	panic("not implemented")
 }
 
+// Friends is the resolver for the friends field.
+func (r *lookaheadUserResolver) Friends(ctx context.Context, obj *LookaheadUser, first *int) ([]*LookaheadUser, error) {
+	panic("not implemented")
+}
+
 // ResolverField is the resolver for the resolverField field.
 func (r *modelMethodsResolver) ResolverField(ctx context.Context, obj *ModelMethods) (bool, error) {
 	panic("not implemented")
@@ -197,6 +202,31 @@ func (r *queryResolver) Overlapping(ctx context.Context) (*OverlappingFields, er
 	panic("not implemented")
 }
 
+// ConstrainedUser is the resolver for the constrainedUser field.
+func (r *queryResolver) ConstrainedUser(ctx context.Context, input ConstrainedUserInput) (bool, error) {
+	panic("not implemented")
+}
+
+// ConstrainedUsers is the resolver for the constrainedUsers field.
+func (r *queryResolver) ConstrainedUsers(ctx context.Context, inputs []*ConstrainedUserInput) (bool, error) {
+	panic("not implemented")
+}
+
+// ConstrainedSearch is the resolver for the constrainedSearch field.
+func (r *queryResolver) ConstrainedSearch(ctx context.Context, term string, limit *int) (bool, error) {
+	panic("not implemented")
+}
+
+// ContractUser is the resolver for the contractUser field.
+func (r *queryResolver) ContractUser(ctx context.Context, id string, withDeleted *bool) (*ContractUser, error) {
+	panic("not implemented")
+}
+
+// ContractAudit is the resolver for the contractAudit field.
+func (r *queryResolver) ContractAudit(ctx context.Context) ([]*ContractAuditEntry, error) {
+	panic("not implemented")
+}
+
 // DefaultParameters is the resolver for the defaultParameters field.
 func (r *queryResolver) DefaultParameters(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error) {
 	panic("not implemented")
@@ -292,6 +322,11 @@ func (r *queryResolver) EnumInInput(ctx context.Context, input *InputWithEnumVal
 	panic("not implemented")
 }
 
+// ExtendedScalars is the resolver for the extendedScalars field.
+func (r *queryResolver) ExtendedScalars(ctx context.Context, input ExtendedScalarsInput) (*ExtendedScalars, error) {
+	panic("not implemented")
+}
+
 // SearchProducts is the resolver for the searchProducts field.
 func (r *queryResolver) SearchProducts(ctx context.Context, filters map[string]interface{}) ([]string, error) {
 	panic("not implemented")
@@ -372,6 +407,16 @@ func (r *queryResolver) Issue896a(ctx context.Context) ([]*CheckIssue896, error)
 	panic("not implemented")
 }
 
+// LookaheadUser is the resolver for the lookaheadUser field.
+func (r *queryResolver) LookaheadUser(ctx context.Context) (*LookaheadUser, error) {
+	panic("not implemented")
+}
+
+// LookaheadSearch is the resolver for the lookaheadSearch field.
+func (r *queryResolver) LookaheadSearch(ctx context.Context) ([]LookaheadResult, error) {
+	panic("not implemented")
+}
+
 // MapStringInterface is the resolver for the mapStringInterface field.
 func (r *queryResolver) MapStringInterface(ctx context.Context, in map[string]any) (map[string]any, error) {
 	panic("not implemented")
@@ -457,6 +502,11 @@ func (r *queryResolver) DefaultScalar(ctx context.Context, arg string) (string,
 	panic("not implemented")
 }
 
+// Semantic is the resolver for the semantic field.
+func (r *queryResolver) Semantic(ctx context.Context) (*Semantic, error) {
+	panic("not implemented")
+}
+
 // SkipInclude is the resolver for the skipInclude field.
 func (r *queryResolver) SkipInclude(ctx context.Context) (*SkipIncludeTestType, error) {
 	panic("not implemented")
@@ -472,6 +522,16 @@ func (r *queryResolver) ScalarSlice(ctx context.Context) ([]byte, error) {
 	panic("not implemented")
 }
 
+// Timeouts is the resolver for the timeouts field.
+func (r *queryResolver) Timeouts(ctx context.Context) (*Timeouts, error) {
+	panic("not implemented")
+}
+
+// SlowOperation is the resolver for the slowOperation field.
+func (r *queryResolver) SlowOperation(ctx context.Context) (*string, error) {
+	panic("not implemented")
+}
+
 // Fallback is the resolver for the fallback field.
 func (r *queryResolver) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	panic("not implemented")
@@ -522,6 +582,21 @@ func (r *queryResolver) WrappedSlice(ctx context.Context) (WrappedSlice, error)
 	panic("not implemented")
 }
 
+// Name is the resolver for the name field.
+func (r *semanticResolver) Name(ctx context.Context, obj *Semantic) (*string, error) {
+	panic("not implemented")
+}
+
+// Names is the resolver for the names field.
+func (r *semanticResolver) Names(ctx context.Context, obj *Semantic) ([]*string, error) {
+	panic("not implemented")
+}
+
+// Required is the resolver for the required field.
+func (r *semanticResolver) Required(ctx context.Context, obj *Semantic) (string, error) {
+	panic("not implemented")
+}
+
 // Updated is the resolver for the updated field.
 func (r *subscriptionResolver) Updated(ctx context.Context) (<-chan string, error) {
 	panic("not implemented")
@@ -562,6 +637,26 @@ func (r *subscriptionResolver) ErrorRequired(ctx context.Context) (<-chan *Error
 	panic("not implemented")
 }
 
+// TimeoutTicks is the resolver for the timeoutTicks field.
+func (r *subscriptionResolver) TimeoutTicks(ctx context.Context) (<-chan string, error) {
+	panic("not implemented")
+}
+
+// Fast is the resolver for the fast field.
+func (r *timeoutsResolver) Fast(ctx context.Context, obj *Timeouts) (*string, error) {
+	panic("not implemented")
+}
+
+// Slow is the resolver for the slow field.
+func (r *timeoutsResolver) Slow(ctx context.Context, obj *Timeouts) (*string, error) {
+	panic("not implemented")
+}
+
+// Configured is the resolver for the configured field.
+func (r *timeoutsResolver) Configured(ctx context.Context, obj *Timeouts) (*string, error) {
+	panic("not implemented")
+}
+
 // Friends is the resolver for the friends field.
 func (r *userResolver) Friends(ctx context.Context, obj *User) ([]*User, error) {
 	panic("not implemented")
@@ -596,6 +691,9 @@ func (r *Resolver) Errors() ErrorsResolver { return &errorsResolver{r} }
 // ForcedResolver returns ForcedResolverResolver implementation.
 func (r *Resolver) ForcedResolver() ForcedResolverResolver { return &forcedResolverResolver{r} }
 
+// LookaheadUser returns LookaheadUserResolver implementation.
+func (r *Resolver) LookaheadUser() LookaheadUserResolver { return &lookaheadUserResolver{r} }
+
 // ModelMethods returns ModelMethodsResolver implementation.
 func (r *Resolver) ModelMethods() ModelMethodsResolver { return &modelMethodsResolver{r} }
 
@@ -622,9 +720,15 @@ func (r *Resolver) PrimitiveString() PrimitiveStringResolver { return &primitive
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Semantic returns SemanticResolver implementation.
+func (r *Resolver) Semantic() SemanticResolver { return &semanticResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
+// Timeouts returns TimeoutsResolver implementation.
+func (r *Resolver) Timeouts() TimeoutsResolver { return &timeoutsResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
@@ -638,6 +742,7 @@ type backedByInterfaceResolver struct{ *Resolver }
 type deferModelResolver struct{ *Resolver }
 type errorsResolver struct{ *Resolver }
 type forcedResolverResolver struct{ *Resolver }
+type lookaheadUserResolver struct{ *Resolver }
 type modelMethodsResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type overlappingFieldsResolver struct{ *Resolver }
@@ -646,7 +751,9 @@ type petResolver struct{ *Resolver }
 type primitiveResolver struct{ *Resolver }
 type primitiveStringResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type semanticResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type timeoutsResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type wrappedMapResolver struct{ *Resolver }
 type wrappedSliceResolver struct{ *Resolver }
@@ -0,0 +1,55 @@
+package followschema
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestStreamedResponses(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.ExtendedScalars = func(ctx context.Context, input ExtendedScalarsInput) (*ExtendedScalars, error) {
+		return &ExtendedScalars{Count: 3, Ratio: 0.25, URL: &url.URL{Path: "/a"}, BigInt: big.NewInt(1)}, nil
+	}
+	resolvers.MutationResolver.UpdateSomething = func(ctx context.Context, input SpecialInput) (string, error) {
+		return "updated", nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{StreamResponses: true})
+
+	post := func(query string) string {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":`+query+`}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		return w.Body.String()
+	}
+	const input = `{localTime: \"10:15:00\", bigInt: 1, decimal: \"1\", json: 1, url: \"https://example.com\", email: \"gopher@example.com\", count: 1, ratio: 1}`
+
+	t.Run("query", func(t *testing.T) {
+		body := post(`"{ extendedScalars(input: ` + input + `) { count ratio bigInt } }"`)
+		require.Equal(t, `{"data":{"extendedScalars":{"count":3,"ratio":0.25,"bigInt":1}}}`, body)
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+		body := post(`"mutation { updateSomething(input: {nesting: {field: \"gopher@example.com\"}}) }"`)
+		require.Equal(t, `{"data":{"updateSomething":"updated"}}`, body)
+	})
+
+	t.Run("errors raised while writing follow the data", func(t *testing.T) {
+		body := post(`"{ extendedScalars(input: ` + input + `) { count url } }"`)
+		require.True(t, strings.HasPrefix(body, `{"data":{"extendedScalars":{"count":3,"url":null}},"errors":[`), body)
+		require.Contains(t, body, `\"/a\" is not an absolute URL`)
+	})
+}
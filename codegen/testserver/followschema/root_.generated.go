@@ -6,9 +6,11 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/contract"
 	"github.com/99designs/gqlgen/graphql/introspection"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
@@ -31,11 +33,38 @@ type Config struct {
 	Complexity ComplexityRoot
 }
 
+// Contracts are the schema contracts of the config, keyed by name. Each keeps the types and
+// fields of the schema selected by their @tag directives.
+var Contracts = map[string]contract.Contract{
+	"public": {
+		Exclude: []string{"internal"},
+	},
+}
+
+// NewContractExecutableSchema creates an ExecutableSchema serving the variant of the schema of
+// the contract name, requests are validated against it and introspection returns it.
+func NewContractExecutableSchema(name string, cfg Config) (graphql.ExecutableSchema, error) {
+	c, ok := Contracts[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown contract %s", name)
+	}
+	schema := cfg.Schema
+	if schema == nil {
+		schema = parsedSchema
+	}
+	var err error
+	if cfg.Schema, err = c.Filter(schema); err != nil {
+		return nil, fmt.Errorf("contract %s: %w", name, err)
+	}
+	return NewExecutableSchema(cfg), nil
+}
+
 type ResolverRoot interface {
 	BackedByInterface() BackedByInterfaceResolver
 	DeferModel() DeferModelResolver
 	Errors() ErrorsResolver
 	ForcedResolver() ForcedResolverResolver
+	LookaheadUser() LookaheadUserResolver
 	ModelMethods() ModelMethodsResolver
 	Mutation() MutationResolver
 	OverlappingFields() OverlappingFieldsResolver
@@ -44,7 +73,9 @@ type ResolverRoot interface {
 	Primitive() PrimitiveResolver
 	PrimitiveString() PrimitiveStringResolver
 	Query() QueryResolver
+	Semantic() SemanticResolver
 	Subscription() SubscriptionResolver
+	Timeouts() TimeoutsResolver
 	User() UserResolver
 	WrappedMap() WrappedMapResolver
 	WrappedSlice() WrappedSliceResolver
@@ -136,6 +167,17 @@ type ComplexityRoot struct {
 		Foo func(childComplexity int) int
 	}
 
+	ContractAuditEntry struct {
+		ID   func(childComplexity int) int
+		User func(childComplexity int) int
+	}
+
+	ContractUser struct {
+		Email func(childComplexity int) int
+		ID    func(childComplexity int) int
+		Name  func(childComplexity int) int
+	}
+
 	Coordinates struct {
 		X func(childComplexity int) int
 		Y func(childComplexity int) int
@@ -194,6 +236,19 @@ type ComplexityRoot struct {
 		E func(childComplexity int) int
 	}
 
+	ExtendedScalars struct {
+		BigInt    func(childComplexity int) int
+		Count     func(childComplexity int) int
+		DateTime  func(childComplexity int) int
+		Decimal   func(childComplexity int) int
+		Email     func(childComplexity int) int
+		JSON      func(childComplexity int) int
+		LocalTime func(childComplexity int) int
+		Ratio     func(childComplexity int) int
+		Size      func(childComplexity int) int
+		URL       func(childComplexity int) int
+	}
+
 	FieldsOrderPayload struct {
 		FirstFieldValue func(childComplexity int) int
 	}
@@ -220,6 +275,18 @@ type ComplexityRoot struct {
 		ID func(childComplexity int) int
 	}
 
+	LookaheadAddress struct {
+		City   func(childComplexity int) int
+		Street func(childComplexity int) int
+	}
+
+	LookaheadUser struct {
+		Address func(childComplexity int) int
+		Friends func(childComplexity int, first *int) int
+		ID      func(childComplexity int) int
+		Name    func(childComplexity int) int
+	}
+
 	LoopA struct {
 		B func(childComplexity int) int
 	}
@@ -323,6 +390,11 @@ type ComplexityRoot struct {
 		Animal                           func(childComplexity int) int
 		Autobind                         func(childComplexity int) int
 		Collision                        func(childComplexity int) int
+		ConstrainedSearch                func(childComplexity int, term string, limit *int) int
+		ConstrainedUser                  func(childComplexity int, input ConstrainedUserInput) int
+		ConstrainedUsers                 func(childComplexity int, inputs []*ConstrainedUserInput) int
+		ContractAudit                    func(childComplexity int) int
+		ContractUser                     func(childComplexity int, id string, withDeleted *bool) int
 		DefaultParameters                func(childComplexity int, falsyBoolean *bool, truthyBoolean *bool) int
 		DefaultScalar                    func(childComplexity int, arg string) int
 		DeferMultiple                    func(childComplexity int) int
@@ -349,6 +421,7 @@ type ComplexityRoot struct {
 		ErrorBubbleList                  func(childComplexity int) int
 		ErrorList                        func(childComplexity int) int
 		Errors                           func(childComplexity int) int
+		ExtendedScalars                  func(childComplexity int, input ExtendedScalarsInput) int
 		Fallback                         func(childComplexity int, arg FallbackToStringEncoding) int
 		FilterProducts                   func(childComplexity int, query *string, category *string, minPrice *int) int
 		FindProducts                     func(childComplexity int, query *string, category *string, minPrice *int) int
@@ -359,6 +432,8 @@ type ComplexityRoot struct {
 		Invalid                          func(childComplexity int) int
 		InvalidIdentifier                func(childComplexity int) int
 		Issue896a                        func(childComplexity int) int
+		LookaheadSearch                  func(childComplexity int) int
+		LookaheadUser                    func(childComplexity int) int
 		MapInput                         func(childComplexity int, input map[string]any) int
 		MapNestedStringInterface         func(childComplexity int, in *NestedMapInput) int
 		MapStringInterface               func(childComplexity int, in map[string]any) int
@@ -385,12 +460,15 @@ type ComplexityRoot struct {
 		SearchRequired                   func(childComplexity int, name string, age int) int
 		SearchWithDefaults               func(childComplexity int, query *string, limit *int, includeArchived *bool) int
 		SearchWithDirectives             func(childComplexity int, oldField *string, newField *string) int
+		Semantic                         func(childComplexity int) int
 		ShapeUnion                       func(childComplexity int) int
 		Shapes                           func(childComplexity int) int
 		SkipInclude                      func(childComplexity int) int
 		Slices                           func(childComplexity int) int
+		SlowOperation                    func(childComplexity int) int
 		StringFromContextFunction        func(childComplexity int) int
 		StringFromContextInterface       func(childComplexity int) int
+		Timeouts                         func(childComplexity int) int
 		User                             func(childComplexity int, id int) int
 		VOkCaseNil                       func(childComplexity int) int
 		VOkCaseValue                     func(childComplexity int) int
@@ -410,6 +488,13 @@ type ComplexityRoot struct {
 		Width       func(childComplexity int) int
 	}
 
+	Semantic struct {
+		ID       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Names    func(childComplexity int) int
+		Required func(childComplexity int) int
+	}
+
 	Size struct {
 		Height func(childComplexity int) int
 		Weight func(childComplexity int) int
@@ -435,9 +520,17 @@ type ComplexityRoot struct {
 		ErrorRequired          func(childComplexity int) int
 		InitPayload            func(childComplexity int) int
 		Issue896b              func(childComplexity int) int
+		TimeoutTicks           func(childComplexity int) int
 		Updated                func(childComplexity int) int
 	}
 
+	Timeouts struct {
+		Configured func(childComplexity int) int
+		Fast       func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Slow       func(childComplexity int) int
+	}
+
 	User struct {
 		Created func(childComplexity int) int
 		Friends func(childComplexity int) int
@@ -696,6 +789,41 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Content_User.Foo(childComplexity), true
 
+	case "ContractAuditEntry.id":
+		if e.complexity.ContractAuditEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.ContractAuditEntry.ID(childComplexity), true
+
+	case "ContractAuditEntry.user":
+		if e.complexity.ContractAuditEntry.User == nil {
+			break
+		}
+
+		return e.complexity.ContractAuditEntry.User(childComplexity), true
+
+	case "ContractUser.email":
+		if e.complexity.ContractUser.Email == nil {
+			break
+		}
+
+		return e.complexity.ContractUser.Email(childComplexity), true
+
+	case "ContractUser.id":
+		if e.complexity.ContractUser.ID == nil {
+			break
+		}
+
+		return e.complexity.ContractUser.ID(childComplexity), true
+
+	case "ContractUser.name":
+		if e.complexity.ContractUser.Name == nil {
+			break
+		}
+
+		return e.complexity.ContractUser.Name(childComplexity), true
+
 	case "Coordinates.x":
 		if e.complexity.Coordinates.X == nil {
 			break
@@ -871,6 +999,76 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Errors.E(childComplexity), true
 
+	case "ExtendedScalars.bigInt":
+		if e.complexity.ExtendedScalars.BigInt == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.BigInt(childComplexity), true
+
+	case "ExtendedScalars.count":
+		if e.complexity.ExtendedScalars.Count == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.Count(childComplexity), true
+
+	case "ExtendedScalars.dateTime":
+		if e.complexity.ExtendedScalars.DateTime == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.DateTime(childComplexity), true
+
+	case "ExtendedScalars.decimal":
+		if e.complexity.ExtendedScalars.Decimal == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.Decimal(childComplexity), true
+
+	case "ExtendedScalars.email":
+		if e.complexity.ExtendedScalars.Email == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.Email(childComplexity), true
+
+	case "ExtendedScalars.json":
+		if e.complexity.ExtendedScalars.JSON == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.JSON(childComplexity), true
+
+	case "ExtendedScalars.localTime":
+		if e.complexity.ExtendedScalars.LocalTime == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.LocalTime(childComplexity), true
+
+	case "ExtendedScalars.ratio":
+		if e.complexity.ExtendedScalars.Ratio == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.Ratio(childComplexity), true
+
+	case "ExtendedScalars.size":
+		if e.complexity.ExtendedScalars.Size == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.Size(childComplexity), true
+
+	case "ExtendedScalars.url":
+		if e.complexity.ExtendedScalars.URL == nil {
+			break
+		}
+
+		return e.complexity.ExtendedScalars.URL(childComplexity), true
+
 	case "FieldsOrderPayload.firstFieldValue":
 		if e.complexity.FieldsOrderPayload.FirstFieldValue == nil {
 			break
@@ -927,6 +1125,53 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.It.ID(childComplexity), true
 
+	case "LookaheadAddress.city":
+		if e.complexity.LookaheadAddress.City == nil {
+			break
+		}
+
+		return e.complexity.LookaheadAddress.City(childComplexity), true
+
+	case "LookaheadAddress.street":
+		if e.complexity.LookaheadAddress.Street == nil {
+			break
+		}
+
+		return e.complexity.LookaheadAddress.Street(childComplexity), true
+
+	case "LookaheadUser.address":
+		if e.complexity.LookaheadUser.Address == nil {
+			break
+		}
+
+		return e.complexity.LookaheadUser.Address(childComplexity), true
+
+	case "LookaheadUser.friends":
+		if e.complexity.LookaheadUser.Friends == nil {
+			break
+		}
+
+		args, err := ec.field_LookaheadUser_friends_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LookaheadUser.Friends(childComplexity, args["first"].(*int)), true
+
+	case "LookaheadUser.id":
+		if e.complexity.LookaheadUser.ID == nil {
+			break
+		}
+
+		return e.complexity.LookaheadUser.ID(childComplexity), true
+
+	case "LookaheadUser.name":
+		if e.complexity.LookaheadUser.Name == nil {
+			break
+		}
+
+		return e.complexity.LookaheadUser.Name(childComplexity), true
+
 	case "LoopA.b":
 		if e.complexity.LoopA.B == nil {
 			break
@@ -1282,6 +1527,61 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Collision(childComplexity), true
 
+	case "Query.constrainedSearch":
+		if e.complexity.Query.ConstrainedSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Query_constrainedSearch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ConstrainedSearch(childComplexity, args["term"].(string), args["limit"].(*int)), true
+
+	case "Query.constrainedUser":
+		if e.complexity.Query.ConstrainedUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_constrainedUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ConstrainedUser(childComplexity, args["input"].(ConstrainedUserInput)), true
+
+	case "Query.constrainedUsers":
+		if e.complexity.Query.ConstrainedUsers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_constrainedUsers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ConstrainedUsers(childComplexity, args["inputs"].([]*ConstrainedUserInput)), true
+
+	case "Query.contractAudit":
+		if e.complexity.Query.ContractAudit == nil {
+			break
+		}
+
+		return e.complexity.Query.ContractAudit(childComplexity), true
+
+	case "Query.contractUser":
+		if e.complexity.Query.ContractUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_contractUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ContractUser(childComplexity, args["id"].(string), args["withDeleted"].(*bool)), true
+
 	case "Query.defaultParameters":
 		if e.complexity.Query.DefaultParameters == nil {
 			break
@@ -1514,6 +1814,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Errors(childComplexity), true
 
+	case "Query.extendedScalars":
+		if e.complexity.Query.ExtendedScalars == nil {
+			break
+		}
+
+		args, err := ec.field_Query_extendedScalars_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ExtendedScalars(childComplexity, args["input"].(ExtendedScalarsInput)), true
+
 	case "Query.fallback":
 		if e.complexity.Query.Fallback == nil {
 			break
@@ -1614,6 +1926,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Issue896a(childComplexity), true
 
+	case "Query.lookaheadSearch":
+		if e.complexity.Query.LookaheadSearch == nil {
+			break
+		}
+
+		return e.complexity.Query.LookaheadSearch(childComplexity), true
+
+	case "Query.lookaheadUser":
+		if e.complexity.Query.LookaheadUser == nil {
+			break
+		}
+
+		return e.complexity.Query.LookaheadUser(childComplexity), true
+
 	case "Query.mapInput":
 		if e.complexity.Query.MapInput == nil {
 			break
@@ -1856,6 +2182,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.SearchWithDirectives(childComplexity, args["oldField"].(*string), args["newField"].(*string)), true
 
+	case "Query.semantic":
+		if e.complexity.Query.Semantic == nil {
+			break
+		}
+
+		return e.complexity.Query.Semantic(childComplexity), true
+
 	case "Query.shapeUnion":
 		if e.complexity.Query.ShapeUnion == nil {
 			break
@@ -1884,6 +2217,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Slices(childComplexity), true
 
+	case "Query.slowOperation":
+		if e.complexity.Query.SlowOperation == nil {
+			break
+		}
+
+		return e.complexity.Query.SlowOperation(childComplexity), true
+
 	case "Query.stringFromContextFunction":
 		if e.complexity.Query.StringFromContextFunction == nil {
 			break
@@ -1898,6 +2238,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.StringFromContextInterface(childComplexity), true
 
+	case "Query.timeouts":
+		if e.complexity.Query.Timeouts == nil {
+			break
+		}
+
+		return e.complexity.Query.Timeouts(childComplexity), true
+
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -2001,6 +2348,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Rectangle.Width(childComplexity), true
 
+	case "Semantic.id":
+		if e.complexity.Semantic.ID == nil {
+			break
+		}
+
+		return e.complexity.Semantic.ID(childComplexity), true
+
+	case "Semantic.name":
+		if e.complexity.Semantic.Name == nil {
+			break
+		}
+
+		return e.complexity.Semantic.Name(childComplexity), true
+
+	case "Semantic.names":
+		if e.complexity.Semantic.Names == nil {
+			break
+		}
+
+		return e.complexity.Semantic.Names(childComplexity), true
+
+	case "Semantic.required":
+		if e.complexity.Semantic.Required == nil {
+			break
+		}
+
+		return e.complexity.Semantic.Required(childComplexity), true
+
 	case "Size.height":
 		if e.complexity.Size.Height == nil {
 			break
@@ -2116,6 +2491,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Subscription.Issue896b(childComplexity), true
 
+	case "Subscription.timeoutTicks":
+		if e.complexity.Subscription.TimeoutTicks == nil {
+			break
+		}
+
+		return e.complexity.Subscription.TimeoutTicks(childComplexity), true
+
 	case "Subscription.updated":
 		if e.complexity.Subscription.Updated == nil {
 			break
@@ -2123,6 +2505,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Subscription.Updated(childComplexity), true
 
+	case "Timeouts.configured":
+		if e.complexity.Timeouts.Configured == nil {
+			break
+		}
+
+		return e.complexity.Timeouts.Configured(childComplexity), true
+
+	case "Timeouts.fast":
+		if e.complexity.Timeouts.Fast == nil {
+			break
+		}
+
+		return e.complexity.Timeouts.Fast(childComplexity), true
+
+	case "Timeouts.id":
+		if e.complexity.Timeouts.ID == nil {
+			break
+		}
+
+		return e.complexity.Timeouts.ID(childComplexity), true
+
+	case "Timeouts.slow":
+		if e.complexity.Timeouts.Slow == nil {
+			break
+		}
+
+		return e.complexity.Timeouts.Slow(childComplexity), true
+
 	case "User.created":
 		if e.complexity.User.Created == nil {
 			break
@@ -2297,13 +2707,236 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	return 0, false
 }
 
+// GoFieldName implements graphql.GoFieldMapper, it returns the Go struct field the field of an
+// object type is bound to.
+func (e *executableSchema) GoFieldName(typeName, field string) (string, bool) {
+	switch typeName + "." + field {
+	case "A.id":
+		return "ID", true
+	case "AIt.id":
+		return "ID", true
+	case "AbIt.id":
+		return "ID", true
+	case "Autobind.int":
+		return "Int", true
+	case "Autobind.int32":
+		return "Int32", true
+	case "Autobind.int64":
+		return "Int64", true
+	case "Autobind.idStr":
+		return "IdStr", true
+	case "Autobind.idInt":
+		return "IdInt", true
+	case "B.id":
+		return "ID", true
+	case "Cat.species":
+		return "Species", true
+	case "Cat.size":
+		return "Size", true
+	case "Cat.catBreed":
+		return "CatBreed", true
+	case "CheckIssue896.id":
+		return "ID", true
+	case "Circle.radius":
+		return "Radius", true
+	case "Circle.coordinates":
+		return "Coordinates", true
+	case "ConcreteNodeA.id":
+		return "ID", true
+	case "ConcreteNodeA.name":
+		return "Name", true
+	case "Content_Post.foo":
+		return "Foo", true
+	case "Content_User.foo":
+		return "Foo", true
+	case "ContractAuditEntry.id":
+		return "ID", true
+	case "ContractAuditEntry.user":
+		return "User", true
+	case "ContractUser.id":
+		return "ID", true
+	case "ContractUser.name":
+		return "Name", true
+	case "ContractUser.email":
+		return "Email", true
+	case "Coordinates.x":
+		return "X", true
+	case "Coordinates.y":
+		return "Y", true
+	case "DefaultParametersMirror.falsyBoolean":
+		return "FalsyBoolean", true
+	case "DefaultParametersMirror.truthyBoolean":
+		return "TruthyBoolean", true
+	case "DeferModel.id":
+		return "ID", true
+	case "DeferModel.name":
+		return "Name", true
+	case "Dog.species":
+		return "Species", true
+	case "Dog.size":
+		return "Size", true
+	case "Dog.dogBreed":
+		return "DogBreed", true
+	case "EmbeddedDefaultScalar.value":
+		return "Value", true
+	case "EmbeddedPointer.ID":
+		return "ID", true
+	case "EmbeddedPointer.Title":
+		return "Title", true
+	case "Error.id":
+		return "ID", true
+	case "ExtendedScalars.localTime":
+		return "LocalTime", true
+	case "ExtendedScalars.dateTime":
+		return "DateTime", true
+	case "ExtendedScalars.bigInt":
+		return "BigInt", true
+	case "ExtendedScalars.decimal":
+		return "Decimal", true
+	case "ExtendedScalars.json":
+		return "JSON", true
+	case "ExtendedScalars.url":
+		return "URL", true
+	case "ExtendedScalars.email":
+		return "Email", true
+	case "ExtendedScalars.count":
+		return "Count", true
+	case "ExtendedScalars.size":
+		return "Size", true
+	case "ExtendedScalars.ratio":
+		return "Ratio", true
+	case "FieldsOrderPayload.firstFieldValue":
+		return "FirstFieldValue", true
+	case "Horse.species":
+		return "Species", true
+	case "Horse.size":
+		return "Size", true
+	case "Horse.horseBreed":
+		return "HorseBreed", true
+	case "InnerObject.id":
+		return "ID", true
+	case "InvalidIdentifier.id":
+		return "ID", true
+	case "It.id":
+		return "ID", true
+	case "LookaheadAddress.city":
+		return "City", true
+	case "LookaheadAddress.street":
+		return "Street", true
+	case "LookaheadUser.id":
+		return "ID", true
+	case "LookaheadUser.name":
+		return "Name", true
+	case "LookaheadUser.address":
+		return "Address", true
+	case "LoopA.b":
+		return "B", true
+	case "LoopB.a":
+		return "A", true
+	case "Map.id":
+		return "ID", true
+	case "MapNested.value":
+		return "Value", true
+	case "ObjectDirectives.text":
+		return "Text", true
+	case "ObjectDirectives.nullableText":
+		return "NullableText", true
+	case "ObjectDirectives.order":
+		return "Order", true
+	case "ObjectDirectivesWithCustomGoModel.nullableText":
+		return "NullableText", true
+	case "OuterObject.inner":
+		return "Inner", true
+	case "OverlappingFields.oneFoo":
+		return "Foo", true
+	case "OverlappingFields.twoFoo":
+		return "Foo", true
+	case "OverlappingFields.newFoo":
+		return "NewFoo", true
+	case "OverlappingFields.new_foo":
+		return "NewFoo", true
+	case "Pet.id":
+		return "ID", true
+	case "PtrToAnyContainer.ptrToAny":
+		return "PtrToAny", true
+	case "PtrToPtrInner.key":
+		return "Key", true
+	case "PtrToPtrInner.value":
+		return "Value", true
+	case "PtrToPtrOuter.name":
+		return "Name", true
+	case "PtrToPtrOuter.inner":
+		return "Inner", true
+	case "PtrToPtrOuter.stupidInner":
+		return "StupidInner", true
+	case "PtrToSliceContainer.ptrToSlice":
+		return "PtrToSlice", true
+	case "Rectangle.length":
+		return "Length", true
+	case "Rectangle.width":
+		return "Width", true
+	case "Rectangle.coordinates":
+		return "Coordinates", true
+	case "Semantic.id":
+		return "ID", true
+	case "Size.height":
+		return "Height", true
+	case "Size.weight":
+		return "Weight", true
+	case "SkipIncludeTestType.a":
+		return "A", true
+	case "SkipIncludeTestType.b":
+		return "B", true
+	case "Slices.test1":
+		return "Test1", true
+	case "Slices.test2":
+		return "Test2", true
+	case "Slices.test3":
+		return "Test3", true
+	case "Slices.test4":
+		return "Test4", true
+	case "Timeouts.id":
+		return "ID", true
+	case "User.id":
+		return "ID", true
+	case "User.created":
+		return "Created", true
+	case "User.updated":
+		return "Updated", true
+	case "ValidType.differentCase":
+		return "DifferentCase", true
+	case "ValidType.different_case":
+		return "DifferentCaseOld", true
+	case "ValidType.validInputKeywords":
+		return "ValidInputKeywords", true
+	case "ValidType.validArgs":
+		return "ValidArgs", true
+	case "WrappedStruct.name":
+		return "Name", true
+	case "WrappedStruct.desc":
+		return "Desc", true
+	case "XXIt.id":
+		return "ID", true
+	case "XxIt.id":
+		return "ID", true
+	case "asdfIt.id":
+		return "ID", true
+	case "iIt.id":
+		return "ID", true
+	}
+	return "", false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputChanges,
+		ec.unmarshalInputConstrainedAddressInput,
+		ec.unmarshalInputConstrainedUserInput,
 		ec.unmarshalInputDefaultInput,
 		ec.unmarshalInputDirectiveInput,
+		ec.unmarshalInputExtendedScalarsInput,
 		ec.unmarshalInputFieldsOrderInput,
 		ec.unmarshalInputInnerDirectives,
 		ec.unmarshalInputInnerInput,
@@ -2348,9 +2981,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 					return nil
 				}
 			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
+			response.SetData(ctx, data)
 			if atomic.LoadInt32(&ec.deferred) > 0 {
 				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
 				response.HasNext = &hasNext
@@ -2366,12 +2997,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			first = false
 			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
 			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+			var response graphql.Response
+			response.SetData(ctx, data)
+			return &response
 		}
 	case ast.Subscription:
 		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
@@ -2416,7 +3044,7 @@ func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
 			Errors: graphql.GetErrors(ctx),
 		}
 		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
+		if dg.FieldSet.IsNull(ctx) {
 			ds.Result = graphql.Null
 		}
 		ec.deferredResults <- ds
@@ -2427,22 +3055,32 @@ func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapSchema(schema), nil
 }
 
 func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.Schema()
+	if ec.DisableErrorPropagation {
+		schema = introspection.SemanticNonNullSchema(schema)
+	}
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
 }
 
 var sources = []*ast.Source{
-	{Name: "inline_arguments_transformed_schema.graphql", Input: `directive @custom on ARGUMENT_DEFINITION
+	{Name: "inline_arguments_transformed_schema.graphql", Input: `directive @constraint(minLength: Int, maxLength: Int, pattern: String, min: Float, max: Float, format: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+directive @custom on ARGUMENT_DEFINITION
 directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
 directive @directive1 on FIELD_DEFINITION
 directive @directive2 on FIELD_DEFINITION
 directive @directive3 on INPUT_OBJECT
+directive @experimental_disableErrorPropagation on QUERY | MUTATION | SUBSCRIPTION
 directive @goField(forceResolver: Boolean, name: String, omittable: Boolean, type: String) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION
 directive @goModel(model: String, models: [String!]) on OBJECT | INPUT_OBJECT | SCALAR | ENUM | INTERFACE | UNION
 directive @inlineArguments on ARGUMENT_DEFINITION
@@ -2455,6 +3093,9 @@ directive @order1(location: String!) repeatable on FIELD_DEFINITION | OBJECT
 directive @order2(location: String!) on OBJECT
 directive @populate(value: String!) on ARGUMENT_DEFINITION
 directive @range(min: Int = 0, max: Int) on ARGUMENT_DEFINITION
+directive @semanticNonNull(levels: [Int] = [0]) on FIELD_DEFINITION
+directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
+directive @timeout(ms: Int!) on FIELD_DEFINITION | OBJECT
 directive @toNull on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
 directive @unimplemented on FIELD_DEFINITION
 type A {
@@ -2486,6 +3127,7 @@ type BackedByInterface {
 	thisShouldBind: String!
 	thisShouldBindWithError: String!
 }
+scalar BigInt @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/big-int")
 scalar Bytes
 type Cat implements Animal {
 	species: String!
@@ -2516,6 +3158,17 @@ type ConcreteNodeInterface implements Node {
 	id: ID!
 	child: Node!
 }
+input ConstrainedAddressInput {
+	zip: String! @constraint(pattern: "^[0-9]{5}$")
+}
+input ConstrainedUserInput {
+	name: String! @constraint(minLength: 2, maxLength: 10)
+	email: String! @constraint(format: "email")
+	age: Int @constraint(min: 0, max: 150)
+	tags: [String!] @constraint(maxLength: 2, pattern: "^[a-z]+$")
+	handle: String @constraint(format: "slug")
+	address: ConstrainedAddressInput
+}
 union Content_Child = Content_User | Content_Post
 type Content_Post {
 	foo: String
@@ -2523,11 +3176,22 @@ type Content_Post {
 type Content_User {
 	foo: String
 }
+type ContractAuditEntry @tag(name: "internal") {
+	id: ID!
+	user: ContractUser
+}
+type ContractUser {
+	id: ID!
+	name: String!
+	email: String @tag(name: "internal")
+}
 type Coordinates {
 	x: Float!
 	y: Float!
 }
 scalar CustomScalar @goModel(model: "followschema.CustomScalar")
+scalar DateTime @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/date-time")
+scalar Decimal @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/decimal")
 input DefaultInput {
 	falsyBoolean: Boolean = false
 	truthyBoolean: Boolean = true
@@ -2555,6 +3219,7 @@ type Dog implements Animal {
 	dogBreed: String!
 }
 scalar Email
+scalar EmailAddress @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/email-address")
 type EmbeddedCase1 @goModel(model: "followschema.EmbeddedCase1") {
 	exportedEmbeddedPointerExportedMethod: String!
 }
@@ -2588,6 +3253,30 @@ type Errors {
 	d: Error!
 	e: Error!
 }
+type ExtendedScalars {
+	localTime: LocalTime!
+	dateTime: DateTime
+	bigInt: BigInt!
+	decimal: Decimal!
+	json: JSON!
+	url: URL!
+	email: EmailAddress!
+	count: NonNegativeInt!
+	size: PositiveInt
+	ratio: NonNegativeFloat!
+}
+input ExtendedScalarsInput {
+	localTime: LocalTime!
+	dateTime: DateTime
+	bigInt: BigInt!
+	decimal: Decimal!
+	json: JSON!
+	url: URL!
+	email: EmailAddress!
+	count: NonNegativeInt!
+	size: PositiveInt
+	ratio: NonNegativeFloat!
+}
 enum FallbackToStringEncoding {
 	A
 	B
@@ -2633,6 +3322,19 @@ type InvalidIdentifier {
 type It {
 	id: ID!
 }
+scalar JSON @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/json")
+scalar LocalTime @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/local-time")
+type LookaheadAddress {
+	city: String!
+	street: String!
+}
+union LookaheadResult = LookaheadUser | LookaheadAddress
+type LookaheadUser {
+	id: ID!
+	name: String!
+	address: LookaheadAddress!
+	friends(first: Int = 10): [LookaheadUser!]!
+}
 type LoopA {
 	b: LoopB!
 }
@@ -2691,6 +3393,8 @@ interface Node {
 	id: ID!
 	child: Node!
 }
+scalar NonNegativeFloat @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/non-negative-float")
+scalar NonNegativeInt @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/non-negative-int")
 type ObjectDirectives @order1(location: "order1_1") @order1(location: "order1_2") @order2(location: "order2_1") {
 	text: String! @length(min: 0, max: 7, message: "not valid")
 	nullableText: String @toNull
@@ -2731,6 +3435,7 @@ type Pet {
 	id: Int!
 	friends(limit: Int): [Pet!] @goField(forceResolver: true)
 }
+scalar PositiveInt @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/positive-int")
 type Primitive {
 	value: Int!
 	squared: Int!
@@ -2773,6 +3478,11 @@ type Query {
 	autobind: Autobind
 	deprecatedField: String! @deprecated(reason: "test deprecated directive")
 	overlapping: OverlappingFields
+	constrainedUser(input: ConstrainedUserInput!): Boolean!
+	constrainedUsers(inputs: [ConstrainedUserInput!]!): Boolean!
+	constrainedSearch(term: String! @constraint(minLength: 3), limit: Int @constraint(min: 1, max: 100)): Boolean!
+	contractUser(id: ID!, withDeleted: Boolean @tag(name: "internal")): ContractUser
+	contractAudit: [ContractAuditEntry!]! @tag(name: "internal")
 	defaultParameters(falsyBoolean: Boolean = false, truthyBoolean: Boolean = true): DefaultParametersMirror!
 	deferSingle: DeferModel
 	deferMultiple: [DeferModel!]
@@ -2792,6 +3502,7 @@ type Query {
 	embeddedCase2: EmbeddedCase2
 	embeddedCase3: EmbeddedCase3
 	enumInInput(input: InputWithEnumValue): EnumTest!
+	extendedScalars(input: ExtendedScalarsInput!): ExtendedScalars!
 	searchProducts(query: String, category: String, minPrice: Int): [String!]!
 	searchRequired(name: String!, age: Int!): [String!]!
 	searchProductsNormal(filters: SearchFilters): [String!]!
@@ -2808,6 +3519,8 @@ type Query {
 	notAnInterface: BackedByInterface
 	dog: Dog
 	issue896a: [CheckIssue896!]
+	lookaheadUser: LookaheadUser
+	lookaheadSearch: [LookaheadResult!]!
 	mapStringInterface(in: MapStringInterfaceInput): MapStringInterfaceType
 	mapNestedStringInterface(in: NestedMapInput): MapStringInterfaceType
 	errorBubble: Error
@@ -2825,9 +3538,12 @@ type Query {
 	stringFromContextInterface: StringFromContextInterface!
 	stringFromContextFunction: StringFromContextFunction!
 	defaultScalar(arg: DefaultScalarImplementation! = "default"): DefaultScalarImplementation!
+	semantic: Semantic
 	skipInclude: SkipIncludeTestType
 	slices: Slices
 	scalarSlice: Bytes!
+	timeouts: Timeouts
+	slowOperation: String
 	fallback(arg: FallbackToStringEncoding!): FallbackToStringEncoding!
 	optionalUnion: TestUnion
 	vOkCaseValue: VOkCaseValue
@@ -2862,6 +3578,12 @@ input SearchWithDefaults @goModel(model: "map[string]interface{}") {
 	limit: Int = 20
 	includeArchived: Boolean = false
 }
+type Semantic {
+	id: ID!
+	name: String @semanticNonNull
+	names: [String] @semanticNonNull(levels: [0,1])
+	required: String!
+}
 interface Shape {
 	area: Float
 	coordinates: Coordinates
@@ -2890,7 +3612,7 @@ enum Status {
 }
 scalar StringFromContextFunction
 scalar StringFromContextInterface
-type Subscription {
+type Subscription @timeout(ms: 10) {
 	updated: String!
 	initPayload: String!
 	directiveArg(arg: String! @length(min: 1, max: 255, message: "invalid length")): String
@@ -2899,10 +3621,18 @@ type Subscription {
 	directiveUnimplemented: String @unimplemented
 	issue896b: [CheckIssue896]
 	errorRequired: Error!
+	timeoutTicks: String! @timeout(ms: 10)
 }
 union TestUnion = A | B
 scalar ThirdParty @goModel(model: "followschema.ThirdParty")
 scalar Time
+type Timeouts @timeout(ms: 1000) {
+	id: ID!
+	fast: String
+	slow: String @timeout(ms: 10)
+	configured: String
+}
+scalar URL @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/url")
 scalar UUID
 input UpdateProductInput @goModel(model: "map[string]interface{}") {
 	id: ID!
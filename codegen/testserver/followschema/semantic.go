@@ -0,0 +1,5 @@
+package followschema
+
+type Semantic struct {
+	ID string
+}
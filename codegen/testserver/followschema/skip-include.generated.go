@@ -120,7 +120,7 @@ func (ec *executionContext) _SkipIncludeTestType(ctx context.Context, sel ast.Se
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -27,6 +27,9 @@ type Stub struct {
 	ForcedResolverResolver struct {
 		Field func(ctx context.Context, obj *ForcedResolver) (*Circle, error)
 	}
+	LookaheadUserResolver struct {
+		Friends func(ctx context.Context, obj *LookaheadUser, first *int) ([]*LookaheadUser, error)
+	}
 	ModelMethodsResolver struct {
 		ResolverField func(ctx context.Context, obj *ModelMethods) (bool, error)
 	}
@@ -71,6 +74,11 @@ type Stub struct {
 		Autobind                         func(ctx context.Context) (*Autobind, error)
 		DeprecatedField                  func(ctx context.Context) (string, error)
 		Overlapping                      func(ctx context.Context) (*OverlappingFields, error)
+		ConstrainedUser                  func(ctx context.Context, input ConstrainedUserInput) (bool, error)
+		ConstrainedUsers                 func(ctx context.Context, inputs []*ConstrainedUserInput) (bool, error)
+		ConstrainedSearch                func(ctx context.Context, term string, limit *int) (bool, error)
+		ContractUser                     func(ctx context.Context, id string, withDeleted *bool) (*ContractUser, error)
+		ContractAudit                    func(ctx context.Context) ([]*ContractAuditEntry, error)
 		DefaultParameters                func(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error)
 		DeferSingle                      func(ctx context.Context) (*DeferModel, error)
 		DeferMultiple                    func(ctx context.Context) ([]*DeferModel, error)
@@ -90,6 +98,7 @@ type Stub struct {
 		EmbeddedCase2                    func(ctx context.Context) (*EmbeddedCase2, error)
 		EmbeddedCase3                    func(ctx context.Context) (*EmbeddedCase3, error)
 		EnumInInput                      func(ctx context.Context, input *InputWithEnumValue) (EnumTest, error)
+		ExtendedScalars                  func(ctx context.Context, input ExtendedScalarsInput) (*ExtendedScalars, error)
 		SearchProducts                   func(ctx context.Context, filters map[string]interface{}) ([]string, error)
 		SearchRequired                   func(ctx context.Context, filters map[string]interface{}) ([]string, error)
 		SearchProductsNormal             func(ctx context.Context, filters map[string]any) ([]string, error)
@@ -106,6 +115,8 @@ type Stub struct {
 		NotAnInterface                   func(ctx context.Context) (BackedByInterface, error)
 		Dog                              func(ctx context.Context) (*Dog, error)
 		Issue896a                        func(ctx context.Context) ([]*CheckIssue896, error)
+		LookaheadUser                    func(ctx context.Context) (*LookaheadUser, error)
+		LookaheadSearch                  func(ctx context.Context) ([]LookaheadResult, error)
 		MapStringInterface               func(ctx context.Context, in map[string]any) (map[string]any, error)
 		MapNestedStringInterface         func(ctx context.Context, in *NestedMapInput) (map[string]any, error)
 		ErrorBubble                      func(ctx context.Context) (*Error, error)
@@ -123,9 +134,12 @@ type Stub struct {
 		StringFromContextInterface       func(ctx context.Context) (*StringFromContextInterface, error)
 		StringFromContextFunction        func(ctx context.Context) (string, error)
 		DefaultScalar                    func(ctx context.Context, arg string) (string, error)
+		Semantic                         func(ctx context.Context) (*Semantic, error)
 		SkipInclude                      func(ctx context.Context) (*SkipIncludeTestType, error)
 		Slices                           func(ctx context.Context) (*Slices, error)
 		ScalarSlice                      func(ctx context.Context) ([]byte, error)
+		Timeouts                         func(ctx context.Context) (*Timeouts, error)
+		SlowOperation                    func(ctx context.Context) (*string, error)
 		Fallback                         func(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error)
 		OptionalUnion                    func(ctx context.Context) (TestUnion, error)
 		VOkCaseValue                     func(ctx context.Context) (*VOkCaseValue, error)
@@ -137,6 +151,11 @@ type Stub struct {
 		WrappedMap                       func(ctx context.Context) (WrappedMap, error)
 		WrappedSlice                     func(ctx context.Context) (WrappedSlice, error)
 	}
+	SemanticResolver struct {
+		Name     func(ctx context.Context, obj *Semantic) (*string, error)
+		Names    func(ctx context.Context, obj *Semantic) ([]*string, error)
+		Required func(ctx context.Context, obj *Semantic) (string, error)
+	}
 	SubscriptionResolver struct {
 		Updated                func(ctx context.Context) (<-chan string, error)
 		InitPayload            func(ctx context.Context) (<-chan string, error)
@@ -146,6 +165,12 @@ type Stub struct {
 		DirectiveUnimplemented func(ctx context.Context) (<-chan *string, error)
 		Issue896b              func(ctx context.Context) (<-chan []*CheckIssue896, error)
 		ErrorRequired          func(ctx context.Context) (<-chan *Error, error)
+		TimeoutTicks           func(ctx context.Context) (<-chan string, error)
+	}
+	TimeoutsResolver struct {
+		Fast       func(ctx context.Context, obj *Timeouts) (*string, error)
+		Slow       func(ctx context.Context, obj *Timeouts) (*string, error)
+		Configured func(ctx context.Context, obj *Timeouts) (*string, error)
 	}
 	UserResolver struct {
 		Friends func(ctx context.Context, obj *User) ([]*User, error)
@@ -175,6 +200,9 @@ func (r *Stub) Errors() ErrorsResolver {
 func (r *Stub) ForcedResolver() ForcedResolverResolver {
 	return &stubForcedResolver{r}
 }
+func (r *Stub) LookaheadUser() LookaheadUserResolver {
+	return &stubLookaheadUser{r}
+}
 func (r *Stub) ModelMethods() ModelMethodsResolver {
 	return &stubModelMethods{r}
 }
@@ -199,9 +227,15 @@ func (r *Stub) PrimitiveString() PrimitiveStringResolver {
 func (r *Stub) Query() QueryResolver {
 	return &stubQuery{r}
 }
+func (r *Stub) Semantic() SemanticResolver {
+	return &stubSemantic{r}
+}
 func (r *Stub) Subscription() SubscriptionResolver {
 	return &stubSubscription{r}
 }
+func (r *Stub) Timeouts() TimeoutsResolver {
+	return &stubTimeouts{r}
+}
 func (r *Stub) User() UserResolver {
 	return &stubUser{r}
 }
@@ -252,6 +286,12 @@ func (r *stubForcedResolver) Field(ctx context.Context, obj *ForcedResolver) (*C
 	return r.ForcedResolverResolver.Field(ctx, obj)
 }
 
+type stubLookaheadUser struct{ *Stub }
+
+func (r *stubLookaheadUser) Friends(ctx context.Context, obj *LookaheadUser, first *int) ([]*LookaheadUser, error) {
+	return r.LookaheadUserResolver.Friends(ctx, obj, first)
+}
+
 type stubModelMethods struct{ *Stub }
 
 func (r *stubModelMethods) ResolverField(ctx context.Context, obj *ModelMethods) (bool, error) {
@@ -362,6 +402,21 @@ func (r *stubQuery) DeprecatedField(ctx context.Context) (string, error) {
 func (r *stubQuery) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	return r.QueryResolver.Overlapping(ctx)
 }
+func (r *stubQuery) ConstrainedUser(ctx context.Context, input ConstrainedUserInput) (bool, error) {
+	return r.QueryResolver.ConstrainedUser(ctx, input)
+}
+func (r *stubQuery) ConstrainedUsers(ctx context.Context, inputs []*ConstrainedUserInput) (bool, error) {
+	return r.QueryResolver.ConstrainedUsers(ctx, inputs)
+}
+func (r *stubQuery) ConstrainedSearch(ctx context.Context, term string, limit *int) (bool, error) {
+	return r.QueryResolver.ConstrainedSearch(ctx, term, limit)
+}
+func (r *stubQuery) ContractUser(ctx context.Context, id string, withDeleted *bool) (*ContractUser, error) {
+	return r.QueryResolver.ContractUser(ctx, id, withDeleted)
+}
+func (r *stubQuery) ContractAudit(ctx context.Context) ([]*ContractAuditEntry, error) {
+	return r.QueryResolver.ContractAudit(ctx)
+}
 func (r *stubQuery) DefaultParameters(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error) {
 	return r.QueryResolver.DefaultParameters(ctx, falsyBoolean, truthyBoolean)
 }
@@ -419,6 +474,9 @@ func (r *stubQuery) EmbeddedCase3(ctx context.Context) (*EmbeddedCase3, error) {
 func (r *stubQuery) EnumInInput(ctx context.Context, input *InputWithEnumValue) (EnumTest, error) {
 	return r.QueryResolver.EnumInInput(ctx, input)
 }
+func (r *stubQuery) ExtendedScalars(ctx context.Context, input ExtendedScalarsInput) (*ExtendedScalars, error) {
+	return r.QueryResolver.ExtendedScalars(ctx, input)
+}
 func (r *stubQuery) SearchProducts(ctx context.Context, filters map[string]interface{}) ([]string, error) {
 	return r.QueryResolver.SearchProducts(ctx, filters)
 }
@@ -467,6 +525,12 @@ func (r *stubQuery) Dog(ctx context.Context) (*Dog, error) {
 func (r *stubQuery) Issue896a(ctx context.Context) ([]*CheckIssue896, error) {
 	return r.QueryResolver.Issue896a(ctx)
 }
+func (r *stubQuery) LookaheadUser(ctx context.Context) (*LookaheadUser, error) {
+	return r.QueryResolver.LookaheadUser(ctx)
+}
+func (r *stubQuery) LookaheadSearch(ctx context.Context) ([]LookaheadResult, error) {
+	return r.QueryResolver.LookaheadSearch(ctx)
+}
 func (r *stubQuery) MapStringInterface(ctx context.Context, in map[string]any) (map[string]any, error) {
 	return r.QueryResolver.MapStringInterface(ctx, in)
 }
@@ -518,6 +582,9 @@ func (r *stubQuery) StringFromContextFunction(ctx context.Context) (string, erro
 func (r *stubQuery) DefaultScalar(ctx context.Context, arg string) (string, error) {
 	return r.QueryResolver.DefaultScalar(ctx, arg)
 }
+func (r *stubQuery) Semantic(ctx context.Context) (*Semantic, error) {
+	return r.QueryResolver.Semantic(ctx)
+}
 func (r *stubQuery) SkipInclude(ctx context.Context) (*SkipIncludeTestType, error) {
 	return r.QueryResolver.SkipInclude(ctx)
 }
@@ -527,6 +594,12 @@ func (r *stubQuery) Slices(ctx context.Context) (*Slices, error) {
 func (r *stubQuery) ScalarSlice(ctx context.Context) ([]byte, error) {
 	return r.QueryResolver.ScalarSlice(ctx)
 }
+func (r *stubQuery) Timeouts(ctx context.Context) (*Timeouts, error) {
+	return r.QueryResolver.Timeouts(ctx)
+}
+func (r *stubQuery) SlowOperation(ctx context.Context) (*string, error) {
+	return r.QueryResolver.SlowOperation(ctx)
+}
 func (r *stubQuery) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	return r.QueryResolver.Fallback(ctx, arg)
 }
@@ -558,6 +631,18 @@ func (r *stubQuery) WrappedSlice(ctx context.Context) (WrappedSlice, error) {
 	return r.QueryResolver.WrappedSlice(ctx)
 }
 
+type stubSemantic struct{ *Stub }
+
+func (r *stubSemantic) Name(ctx context.Context, obj *Semantic) (*string, error) {
+	return r.SemanticResolver.Name(ctx, obj)
+}
+func (r *stubSemantic) Names(ctx context.Context, obj *Semantic) ([]*string, error) {
+	return r.SemanticResolver.Names(ctx, obj)
+}
+func (r *stubSemantic) Required(ctx context.Context, obj *Semantic) (string, error) {
+	return r.SemanticResolver.Required(ctx, obj)
+}
+
 type stubSubscription struct{ *Stub }
 
 func (r *stubSubscription) Updated(ctx context.Context) (<-chan string, error) {
@@ -584,6 +669,21 @@ func (r *stubSubscription) Issue896b(ctx context.Context) (<-chan []*CheckIssue8
 func (r *stubSubscription) ErrorRequired(ctx context.Context) (<-chan *Error, error) {
 	return r.SubscriptionResolver.ErrorRequired(ctx)
 }
+func (r *stubSubscription) TimeoutTicks(ctx context.Context) (<-chan string, error) {
+	return r.SubscriptionResolver.TimeoutTicks(ctx)
+}
+
+type stubTimeouts struct{ *Stub }
+
+func (r *stubTimeouts) Fast(ctx context.Context, obj *Timeouts) (*string, error) {
+	return r.TimeoutsResolver.Fast(ctx, obj)
+}
+func (r *stubTimeouts) Slow(ctx context.Context, obj *Timeouts) (*string, error) {
+	return r.TimeoutsResolver.Slow(ctx, obj)
+}
+func (r *stubTimeouts) Configured(ctx context.Context, obj *Timeouts) (*string, error) {
+	return r.TimeoutsResolver.Configured(ctx, obj)
+}
 
 type stubUser struct{ *Stub }
 
@@ -0,0 +1,5 @@
+package followschema
+
+type Timeouts struct {
+	ID string
+}
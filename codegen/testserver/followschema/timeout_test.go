@@ -0,0 +1,126 @@
+package followschema
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	late := "late"
+
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Timeouts = func(ctx context.Context) (*Timeouts, error) {
+		return &Timeouts{ID: "1"}, nil
+	}
+	resolvers.QueryResolver.SlowOperation = func(ctx context.Context) (*string, error) {
+		<-release
+		return &late, nil
+	}
+	timeout := func(ctx context.Context, obj *Timeouts) (*string, error) {
+		timeout := graphql.GetFieldContext(ctx).Timeout.String()
+		if _, ok := ctx.Deadline(); !ok {
+			timeout = "no deadline"
+		}
+		return &timeout, nil
+	}
+	resolvers.TimeoutsResolver.Fast = timeout
+	resolvers.TimeoutsResolver.Configured = timeout
+	resolvers.TimeoutsResolver.Slow = func(ctx context.Context, obj *Timeouts) (*string, error) {
+		<-release
+		return &late, nil
+	}
+	resolvers.SubscriptionResolver.TimeoutTicks = func(ctx context.Context) (<-chan string, error) {
+		ticks := make(chan string)
+		go func() {
+			defer close(ticks)
+			for i := 1; i <= 3; i++ {
+				select {
+				case <-time.After(20 * time.Millisecond):
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case ticks <- fmt.Sprint("tick ", i):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ticks, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.Websocket{})
+	c := client.New(srv)
+
+	t.Run("fields resolve with the deadline of their timeout", func(t *testing.T) {
+		var resp struct {
+			Timeouts struct {
+				ID         string
+				Fast       string
+				Configured string
+			}
+		}
+		c.MustPost(`{ timeouts { id fast configured } }`, &resp)
+
+		require.Equal(t, "1", resp.Timeouts.ID)
+		require.Equal(t, "1s", resp.Timeouts.Fast)
+		require.Equal(t, "20ms", resp.Timeouts.Configured)
+	})
+
+	t.Run("timed out fields resolve to null", func(t *testing.T) {
+		var resp struct {
+			Timeouts struct {
+				ID   string
+				Slow *string
+			}
+		}
+		err := c.Post(`{ timeouts { id slow } }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"field timed out","path":["timeouts","slow"],"extensions":{"code":"TIMEOUT"}}]`)
+		require.Equal(t, "1", resp.Timeouts.ID)
+		require.Nil(t, resp.Timeouts.Slow)
+	})
+
+	t.Run("operation timeout", func(t *testing.T) {
+		srv.SetOperationTimeout(10 * time.Millisecond)
+		defer srv.SetOperationTimeout(0)
+
+		var resp struct {
+			SlowOperation *string
+			Timeouts      struct {
+				Fast string
+			}
+		}
+		err := c.Post(`{ slowOperation timeouts { fast } }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"field timed out","path":["slowOperation"],"extensions":{"code":"TIMEOUT"}}]`)
+		require.Nil(t, resp.SlowOperation)
+		require.Equal(t, "1s", resp.Timeouts.Fast)
+	})
+
+	t.Run("subscriptions keep streaming past their timeout", func(t *testing.T) {
+		sub := c.Websocket(`subscription { timeoutTicks }`)
+		defer sub.Close()
+
+		for i := 1; i <= 3; i++ {
+			var resp struct {
+				TimeoutTicks string
+			}
+			require.NoError(t, sub.Next(&resp))
+			require.Equal(t, fmt.Sprint("tick ", i), resp.TimeoutTicks)
+		}
+	})
+}
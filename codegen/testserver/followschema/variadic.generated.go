@@ -141,7 +141,7 @@ func (ec *executionContext) _VariadicModel(ctx context.Context, sel ast.Selectio
 		}
 	}
 	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	if out.IsNull(ctx) {
 		return graphql.Null
 	}
 
@@ -0,0 +1,83 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestContract(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.ContractUser = func(ctx context.Context, id string, withDeleted *bool) (*ContractUser, error) {
+		email := "ada@example.com"
+		return &ContractUser{ID: id, Name: "Ada", Email: &email}, nil
+	}
+	resolvers.QueryResolver.ContractAudit = func(ctx context.Context) ([]*ContractAuditEntry, error) {
+		return []*ContractAuditEntry{{ID: "1"}}, nil
+	}
+
+	public, err := NewContractExecutableSchema("public", Config{Resolvers: resolvers})
+	require.NoError(t, err)
+	srv := handler.New(public)
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	c := client.New(srv)
+
+	t.Run("fields of the contract", func(t *testing.T) {
+		var resp struct {
+			ContractUser struct {
+				ID   string
+				Name string
+			}
+		}
+		c.MustPost(`{ contractUser(id: "1") { id name } }`, &resp)
+		require.Equal(t, "1", resp.ContractUser.ID)
+		require.Equal(t, "Ada", resp.ContractUser.Name)
+	})
+
+	t.Run("fields outside the contract are rejected", func(t *testing.T) {
+		var resp struct{}
+		err := c.Post(`{ contractUser(id: "1") { email } }`, &resp)
+		require.ErrorContains(t, err, `Cannot query field \"email\" on type \"ContractUser\".`)
+
+		err = c.Post(`{ contractUser(id: "1", withDeleted: true) { id } }`, &resp)
+		require.ErrorContains(t, err, `Unknown argument \"withDeleted\" on field \"Query.contractUser\".`)
+
+		err = c.Post(`{ contractAudit { id } }`, &resp)
+		require.ErrorContains(t, err, `Cannot query field \"contractAudit\" on type \"Query\".`)
+	})
+
+	t.Run("introspection returns the contract", func(t *testing.T) {
+		var resp struct {
+			User  struct{ Fields []struct{ Name string } }
+			Audit *struct{ Name string }
+		}
+		c.MustPost(`{
+			user: __type(name: "ContractUser") { fields { name } }
+			audit: __type(name: "ContractAuditEntry") { name }
+		}`, &resp)
+		require.Equal(t, []struct{ Name string }{{"id"}, {"name"}}, resp.User.Fields)
+		require.Nil(t, resp.Audit)
+	})
+
+	t.Run("the full schema is unchanged", func(t *testing.T) {
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		var resp struct {
+			ContractUser struct{ Email string }
+		}
+		client.New(srv).MustPost(`{ contractUser(id: "1", withDeleted: true) { email } }`, &resp)
+		require.Equal(t, "ada@example.com", resp.ContractUser.Email)
+	})
+
+	t.Run("unknown contract", func(t *testing.T) {
+		_, err := NewContractExecutableSchema("partner", Config{Resolvers: resolvers})
+		require.EqualError(t, err, "unknown contract partner")
+	})
+}
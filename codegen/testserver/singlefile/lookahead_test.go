@@ -0,0 +1,90 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestLookahead(t *testing.T) {
+	var sel *graphql.Selection
+	resolvers := &Stub{}
+	resolvers.QueryResolver.LookaheadUser = func(ctx context.Context) (*LookaheadUser, error) {
+		sel = graphql.Lookahead(ctx)
+		return &LookaheadUser{ID: "1"}, nil
+	}
+	resolvers.QueryResolver.LookaheadSearch = func(ctx context.Context) ([]LookaheadResult, error) {
+		sel = graphql.Lookahead(ctx)
+		return nil, nil
+	}
+	resolvers.LookaheadUserResolver.Friends = func(ctx context.Context, obj *LookaheadUser, first *int) ([]*LookaheadUser, error) {
+		return nil, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("object", func(t *testing.T) {
+		var resp any
+		c.MustPost(`query($n: Int) {
+			lookaheadUser {
+				id
+				alias: name
+				... on LookaheadUser { address { city } }
+				friends(first: $n) { name }
+			}
+		}`, &resp, client.Var("n", 2))
+
+		require.Equal(t, "lookaheadUser", sel.Alias)
+		require.Equal(t, "Query", sel.ObjectType)
+		require.Equal(t, []string{"Address.City", "ID", "Name"}, sel.GoFieldPaths())
+		require.True(t, sel.Has("friends", "name"))
+		require.False(t, sel.Has("address", "street"))
+
+		fields := sel.Fields("")
+		require.Len(t, fields, 4)
+		require.Equal(t, "alias", fields[1].Alias)
+		require.Equal(t, "name", fields[1].Name)
+		require.Equal(t, "Name", fields[1].GoField)
+		require.Equal(t, "friends", fields[3].Name)
+		require.Empty(t, fields[3].GoField)
+		require.Equal(t, map[string]any{"first": int64(2)}, fields[3].Arguments)
+	})
+
+	t.Run("default arguments", func(t *testing.T) {
+		var resp any
+		c.MustPost(`{ lookaheadUser { friends { id } } }`, &resp)
+
+		require.Equal(t, map[string]any{"first": int64(10)}, sel.Fields("LookaheadUser")[0].Arguments)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		var resp any
+		c.MustPost(`{
+			lookaheadSearch {
+				__typename
+				... on LookaheadUser { name }
+				... on LookaheadAddress { city street }
+			}
+		}`, &resp)
+
+		require.Len(t, sel.Selections, 2)
+		names := func(fields []*graphql.Selection) []string {
+			var names []string
+			for _, f := range fields {
+				names = append(names, f.Name)
+			}
+			return names
+		}
+		require.Equal(t, []string{"__typename", "name"}, names(sel.Fields("LookaheadUser")))
+		require.Equal(t, []string{"__typename", "city", "street"}, names(sel.Fields("LookaheadAddress")))
+		require.Equal(t, []string{"City", "Name", "Street"}, sel.GoFieldPaths())
+	})
+}
@@ -6,10 +6,13 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math/big"
+	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/scalars"
 )
 
 type Animal interface {
@@ -22,6 +25,10 @@ type ContentChild interface {
 	IsContentChild()
 }
 
+type LookaheadResult interface {
+	IsLookaheadResult()
+}
+
 type Mammalian interface {
 	IsAnimal()
 	IsMammalian()
@@ -67,6 +74,19 @@ type CheckIssue896 struct {
 	ID *int `json:"id,omitempty"`
 }
 
+type ConstrainedAddressInput struct {
+	Zip string `json:"zip"`
+}
+
+type ConstrainedUserInput struct {
+	Name    string                   `json:"name"`
+	Email   string                   `json:"email"`
+	Age     *int                     `json:"age,omitempty"`
+	Tags    []string                 `json:"tags,omitempty"`
+	Handle  *string                  `json:"handle,omitempty"`
+	Address *ConstrainedAddressInput `json:"address,omitempty"`
+}
+
 type ContentPost struct {
 	Foo *string `json:"foo,omitempty"`
 }
@@ -79,6 +99,17 @@ type ContentUser struct {
 
 func (ContentUser) IsContentChild() {}
 
+type ContractAuditEntry struct {
+	ID   string        `json:"id"`
+	User *ContractUser `json:"user,omitempty"`
+}
+
+type ContractUser struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+}
+
 type Coordinates struct {
 	X float64 `json:"x"`
 	Y float64 `json:"y"`
@@ -114,6 +145,32 @@ type EmbeddedDefaultScalar struct {
 	Value *string `json:"value,omitempty"`
 }
 
+type ExtendedScalars struct {
+	LocalTime time.Time    `json:"localTime"`
+	DateTime  *time.Time   `json:"dateTime,omitempty"`
+	BigInt    *big.Int     `json:"bigInt"`
+	Decimal   *big.Rat     `json:"decimal"`
+	JSON      scalars.JSON `json:"json"`
+	URL       *url.URL     `json:"url"`
+	Email     string       `json:"email"`
+	Count     int          `json:"count"`
+	Size      *int         `json:"size,omitempty"`
+	Ratio     float64      `json:"ratio"`
+}
+
+type ExtendedScalarsInput struct {
+	LocalTime time.Time    `json:"localTime"`
+	DateTime  *time.Time   `json:"dateTime,omitempty"`
+	BigInt    *big.Int     `json:"bigInt"`
+	Decimal   *big.Rat     `json:"decimal"`
+	JSON      scalars.JSON `json:"json"`
+	URL       *url.URL     `json:"url"`
+	Email     string       `json:"email"`
+	Count     int          `json:"count"`
+	Size      *int         `json:"size,omitempty"`
+	Ratio     float64      `json:"ratio"`
+}
+
 type FieldsOrderPayload struct {
 	FirstFieldValue *string `json:"firstFieldValue,omitempty"`
 }
@@ -0,0 +1,5 @@
+package singlefile
+
+type Semantic struct {
+	ID string
+}
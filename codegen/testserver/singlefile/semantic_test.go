@@ -0,0 +1,145 @@
+package singlefile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSemanticNonNull(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Semantic = func(ctx context.Context) (*Semantic, error) {
+		return &Semantic{ID: "1"}, nil
+	}
+	resolvers.SemanticResolver.Name = func(ctx context.Context, obj *Semantic) (*string, error) {
+		return nil, errors.New("boom")
+	}
+	resolvers.SemanticResolver.Names = func(ctx context.Context, obj *Semantic) ([]*string, error) {
+		name := "a"
+		return []*string{&name, nil}, nil
+	}
+	resolvers.SemanticResolver.Required = func(ctx context.Context, obj *Semantic) (string, error) {
+		return "", errors.New("boom")
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	c := client.New(srv)
+
+	type semantic struct {
+		ID       string
+		Name     *string
+		Names    []*string
+		Required *string
+	}
+
+	t.Run("semantic non-null fields do not bubble", func(t *testing.T) {
+		var resp struct {
+			Semantic *semantic
+		}
+		err := c.Post(`query { semantic { id name names } }`, &resp)
+
+		require.EqualError(
+			t,
+			err,
+			`[{"message":"boom","path":["semantic","name"]},{"message":"the requested element is null which the schema does not allow","path":["semantic","names"]}]`,
+		)
+		require.NotNil(t, resp.Semantic)
+		require.Equal(t, "1", resp.Semantic.ID)
+		require.Nil(t, resp.Semantic.Name)
+		require.Len(t, resp.Semantic.Names, 2)
+		require.Nil(t, resp.Semantic.Names[1])
+	})
+
+	t.Run("non-null fields bubble", func(t *testing.T) {
+		var resp struct {
+			Semantic *semantic
+		}
+		err := c.Post(`query { semantic { id required } }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"boom","path":["semantic","required"]}]`)
+		require.Nil(t, resp.Semantic)
+	})
+
+	t.Run("operation directive disables error propagation", func(t *testing.T) {
+		var resp struct {
+			Semantic *semantic
+		}
+		err := c.Post(`query @experimental_disableErrorPropagation { semantic { id required } }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"boom","path":["semantic","required"]}]`)
+		require.NotNil(t, resp.Semantic)
+		require.Equal(t, "1", resp.Semantic.ID)
+		require.Nil(t, resp.Semantic.Required)
+	})
+
+	t.Run("request extension disables error propagation", func(t *testing.T) {
+		var resp struct {
+			Semantic *semantic
+		}
+		err := c.Post(
+			`query { semantic { id required } }`,
+			&resp,
+			client.Extensions(map[string]any{"disableErrorPropagation": true}),
+		)
+
+		require.EqualError(t, err, `[{"message":"boom","path":["semantic","required"]}]`)
+		require.NotNil(t, resp.Semantic)
+		require.Nil(t, resp.Semantic.Required)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		type typeRef struct {
+			Kind   string
+			Name   *string
+			OfType *typeRef
+		}
+		var resp struct {
+			Type struct {
+				Fields []struct {
+					Name string
+					Type typeRef
+				}
+			} `json:"__type"`
+		}
+		query := `query%s { __type(name: "Semantic") { fields { name type { kind name ofType { kind name ofType { kind name } } } } } }`
+		fieldKinds := func() map[string]string {
+			kinds := map[string]string{}
+			for _, f := range resp.Type.Fields {
+				kinds[f.Name] = f.Type.Kind
+				if f.Type.OfType != nil {
+					kinds[f.Name] += " " + f.Type.OfType.Kind
+				}
+				if f.Type.OfType != nil && f.Type.OfType.OfType != nil {
+					kinds[f.Name] += " " + f.Type.OfType.OfType.Kind
+				}
+			}
+			return kinds
+		}
+
+		c.MustPost(fmt.Sprintf(query, ""), &resp)
+		require.Equal(t, map[string]string{
+			"id":       "NON_NULL SCALAR",
+			"name":     "SCALAR",
+			"names":    "LIST SCALAR",
+			"required": "NON_NULL SCALAR",
+		}, fieldKinds())
+
+		c.MustPost(fmt.Sprintf(query, " @experimental_disableErrorPropagation"), &resp)
+		require.Equal(t, map[string]string{
+			"id":       "NON_NULL SCALAR",
+			"name":     "NON_NULL SCALAR",
+			"names":    "NON_NULL LIST NON_NULL",
+			"required": "NON_NULL SCALAR",
+		}, fieldKinds())
+	})
+}
@@ -0,0 +1,5 @@
+package singlefile
+
+type Timeouts struct {
+	ID string
+}
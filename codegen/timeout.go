@@ -0,0 +1,60 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// fieldTimeout returns the time the resolver of field has to return: the @timeout of the field, its
+// timeout in the models config, the @timeout of its object or the timeout of its object in the
+// models config, in that order. The timeouts of objects only apply to the fields with a method or
+// a resolver, reading a struct field does not block. It is 0 when none of them is set, and for the
+// fields of the subscription root: their context must last as long as the channel they return.
+func (b *builder) fieldTimeout(obj *Object, field *Field) (time.Duration, error) {
+	if obj.Stream {
+		return 0, nil
+	}
+	if timeout, ok, err := timeoutDirective(field.FieldDefinition.Directives); ok || err != nil {
+		return timeout, err
+	}
+	model := b.Config.Models[obj.Name]
+	if timeout := model.Fields[field.Name].Timeout; timeout != "" {
+		return parseTimeout(timeout)
+	}
+	if !field.IsMethod() && !field.IsResolver {
+		return 0, nil
+	}
+	if timeout, ok, err := timeoutDirective(obj.Definition.Directives); ok || err != nil {
+		return timeout, err
+	}
+	if model.Timeout != "" {
+		return parseTimeout(model.Timeout)
+	}
+	return 0, nil
+}
+
+func timeoutDirective(directives ast.DirectiveList) (time.Duration, bool, error) {
+	d := directives.ForName("timeout")
+	if d == nil {
+		return 0, false, nil
+	}
+	ms, ok := d.ArgumentMap(nil)["ms"].(int64)
+	if !ok || ms <= 0 {
+		return 0, true, errors.New("@timeout(ms:) must be a positive integer")
+	}
+	return time.Duration(ms) * time.Millisecond, true, nil
+}
+
+func parseTimeout(s string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q: %w", s, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout %q: must be positive", s)
+	}
+	return timeout, nil
+}
@@ -2,14 +2,14 @@ package graphql
 
 import (
 	"fmt"
-	"io"
-	"strconv"
 	"strings"
 )
 
 func MarshalBoolean(b bool) Marshaler {
-	str := strconv.FormatBool(b)
-	return WriterFunc(func(w io.Writer) { w.Write([]byte(str)) })
+	if b {
+		return True
+	}
+	return False
 }
 
 func UnmarshalBoolean(v any) (bool, error) {
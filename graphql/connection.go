@@ -0,0 +1,143 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// PageInfo describes the page of a connection, as defined by the Relay cursor connections
+// specification: https://relay.dev/graphql/connections.htm
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+// Edge is a node of a connection together with its cursor.
+type Edge[T any] struct {
+	Node   T      `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+// Connection is a page of a list. The connection types generated for types marked with
+// @connection are aliases of it, so resolvers can return the result of NewConnection or
+// FetchConnection.
+type Connection[T any] struct {
+	Edges      []*Edge[T] `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// ConnectionArgs are the pagination arguments of a connection field.
+type ConnectionArgs struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// PageFetcher returns at most limit items of a list starting at offset, fewer when the list ends
+// before, and the length of the whole list. A limit of 0 asks for the length only.
+type PageFetcher[T any] func(ctx context.Context, offset, limit int) (items []T, total int, err error)
+
+var (
+	ErrNegativeFirst = errors.New("first must not be negative")
+	ErrNegativeLast  = errors.New("last must not be negative")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// NewConnection returns the page of items selected by args.
+func NewConnection[T any](items []T, args ConnectionArgs) (*Connection[T], error) {
+	return FetchConnection(context.Background(), args, func(_ context.Context, offset, limit int) ([]T, int, error) {
+		offset = min(offset, len(items))
+		return items[offset:min(offset+limit, len(items))], len(items), nil
+	})
+}
+
+// FetchConnection returns the page selected by args, fetching only the items on it. The length of
+// the list is fetched first when the page can't be located without it, that is when paging
+// backwards from the end of the list or when neither first nor before are given.
+func FetchConnection[T any](ctx context.Context, args ConnectionArgs, fetch PageFetcher[T]) (*Connection[T], error) {
+	if args.First != nil && *args.First < 0 {
+		return nil, ErrNegativeFirst
+	}
+	if args.Last != nil && *args.Last < 0 {
+		return nil, ErrNegativeLast
+	}
+
+	start := 0
+	if args.After != nil {
+		after, err := DecodeCursor(*args.After)
+		if err != nil {
+			return nil, err
+		}
+		start = after + 1
+	}
+
+	end := -1
+	if args.Before != nil {
+		before, err := DecodeCursor(*args.Before)
+		if err != nil {
+			return nil, err
+		}
+		end = before
+	} else if args.First == nil {
+		_, n, err := fetch(ctx, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		end = n
+	}
+
+	if args.First != nil && (end < 0 || start+*args.First < end) {
+		end = start + *args.First
+	}
+	if args.Last != nil && end-*args.Last > start {
+		start = end - *args.Last
+	}
+	end = max(start, end)
+
+	items, total, err := fetch(ctx, start, end-start)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &Connection[T]{
+		Edges:      make([]*Edge[T], len(items)),
+		TotalCount: total,
+	}
+	for i, item := range items {
+		conn.Edges[i] = &Edge[T]{Node: item, Cursor: EncodeCursor(start + i)}
+	}
+	conn.PageInfo.HasPreviousPage = start > 0
+	conn.PageInfo.HasNextPage = start+len(items) < total
+	if len(items) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(items)-1].Cursor
+	}
+	return conn, nil
+}
+
+const cursorPrefix = "cursor:"
+
+// EncodeCursor returns the opaque cursor of the item at offset in a list.
+func EncodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+// DecodeCursor returns the offset of a cursor returned by EncodeCursor.
+func DecodeCursor(cursor string) (int, error) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(b), cursorPrefix))
+	if err != nil || offset < 0 || !strings.HasPrefix(string(b), cursorPrefix) {
+		return 0, ErrInvalidCursor
+	}
+	return offset, nil
+}
@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnection(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	intp := func(i int) *int { return &i }
+	cursor := func(offset int) *string {
+		c := EncodeCursor(offset)
+		return &c
+	}
+	nodes := func(conn *Connection[string]) []string {
+		var res []string
+		for _, e := range conn.Edges {
+			res = append(res, e.Node)
+		}
+		return res
+	}
+
+	tests := []struct {
+		name            string
+		args            ConnectionArgs
+		nodes           []string
+		hasPreviousPage bool
+		hasNextPage     bool
+	}{
+		{name: "all", nodes: items},
+		{name: "first", args: ConnectionArgs{First: intp(2)}, nodes: []string{"a", "b"}, hasNextPage: true},
+		{name: "first after", args: ConnectionArgs{First: intp(2), After: cursor(1)}, nodes: []string{"c", "d"}, hasPreviousPage: true, hasNextPage: true},
+		{name: "first beyond the end", args: ConnectionArgs{First: intp(10), After: cursor(2)}, nodes: []string{"d", "e"}, hasPreviousPage: true},
+		{name: "last", args: ConnectionArgs{Last: intp(2)}, nodes: []string{"d", "e"}, hasPreviousPage: true},
+		{name: "last before", args: ConnectionArgs{Last: intp(2), Before: cursor(3)}, nodes: []string{"b", "c"}, hasPreviousPage: true, hasNextPage: true},
+		{name: "last beyond the start", args: ConnectionArgs{Last: intp(10), Before: cursor(2)}, nodes: []string{"a", "b"}, hasNextPage: true},
+		{name: "after and before", args: ConnectionArgs{After: cursor(0), Before: cursor(4)}, nodes: []string{"b", "c", "d"}, hasPreviousPage: true, hasNextPage: true},
+		{name: "first and last", args: ConnectionArgs{First: intp(4), Last: intp(2)}, nodes: []string{"c", "d"}, hasPreviousPage: true, hasNextPage: true},
+		{name: "after the end", args: ConnectionArgs{After: cursor(7)}, hasPreviousPage: true},
+		{name: "zero", args: ConnectionArgs{First: intp(0)}, hasNextPage: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := NewConnection(items, tt.args)
+			require.NoError(t, err)
+			require.Equal(t, tt.nodes, nodes(conn))
+			require.Equal(t, len(items), conn.TotalCount)
+			require.Equal(t, tt.hasPreviousPage, conn.PageInfo.HasPreviousPage, "hasPreviousPage")
+			require.Equal(t, tt.hasNextPage, conn.PageInfo.HasNextPage, "hasNextPage")
+			if len(tt.nodes) == 0 {
+				require.Nil(t, conn.PageInfo.StartCursor)
+				require.Nil(t, conn.PageInfo.EndCursor)
+				return
+			}
+			require.Equal(t, conn.Edges[0].Cursor, *conn.PageInfo.StartCursor)
+			require.Equal(t, conn.Edges[len(conn.Edges)-1].Cursor, *conn.PageInfo.EndCursor)
+		})
+	}
+
+	t.Run("cursors continue the list", func(t *testing.T) {
+		first, err := NewConnection(items, ConnectionArgs{First: intp(2)})
+		require.NoError(t, err)
+		next, err := NewConnection(items, ConnectionArgs{First: intp(2), After: first.PageInfo.EndCursor})
+		require.NoError(t, err)
+		require.Equal(t, []string{"c", "d"}, nodes(next))
+		prev, err := NewConnection(items, ConnectionArgs{Last: intp(2), Before: next.PageInfo.StartCursor})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, nodes(prev))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := NewConnection(items, ConnectionArgs{First: intp(-1)})
+		require.ErrorIs(t, err, ErrNegativeFirst)
+		_, err = NewConnection(items, ConnectionArgs{Last: intp(-1)})
+		require.ErrorIs(t, err, ErrNegativeLast)
+		invalid := "nope"
+		_, err = NewConnection(items, ConnectionArgs{After: &invalid})
+		require.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestFetchConnection(t *testing.T) {
+	type call struct{ offset, limit int }
+	var calls []call
+	fetch := func(_ context.Context, offset, limit int) ([]int, int, error) {
+		calls = append(calls, call{offset, limit})
+		var items []int
+		for i := offset; i < offset+limit && i < 100; i++ {
+			items = append(items, i)
+		}
+		return items, 100, nil
+	}
+
+	first := 3
+	after := EncodeCursor(9)
+	conn, err := FetchConnection(context.Background(), ConnectionArgs{First: &first, After: &after}, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []call{{10, 3}}, calls)
+	require.Equal(t, 10, conn.Edges[0].Node)
+	require.Equal(t, 100, conn.TotalCount)
+
+	// the end of the list has to be known to page backwards from it
+	calls = nil
+	conn, err = FetchConnection(context.Background(), ConnectionArgs{Last: &first}, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []call{{0, 0}, {97, 3}}, calls)
+	require.Equal(t, 99, conn.Edges[2].Node)
+	require.False(t, conn.PageInfo.HasNextPage)
+	require.True(t, conn.PageInfo.HasPreviousPage)
+}
+
+func TestCursor(t *testing.T) {
+	for _, offset := range []int{0, 1, 42, 1 << 40} {
+		offset2, err := DecodeCursor(EncodeCursor(offset))
+		require.NoError(t, err)
+		require.Equal(t, offset, offset2)
+	}
+
+	for _, cursor := range []string{"", "nope", EncodeCursor(-1)[:4], "Y3Vyc29yOi0x", "b2Zmc2V0OjE="} {
+		_, err := DecodeCursor(cursor)
+		require.ErrorIs(t, err, ErrInvalidCursor, cursor)
+	}
+}
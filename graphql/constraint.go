@@ -0,0 +1,196 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ConstraintViolation is the code in the extensions of the errors returned for values breaking a
+// @constraint.
+const ConstraintViolation = "CONSTRAINT_VIOLATION"
+
+// Constraint holds the rules of a @constraint directive. The generated code checks the input
+// fields and arguments marked with it after unmarshalling them.
+//
+// The length rules apply to strings and lists, the other rules to strings and numbers or to the
+// items of lists of them.
+type Constraint struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   *regexp.Regexp
+	Min       *float64
+	Max       *float64
+	Format    string
+	// FormatFunc checks Format when it is registered in the config, the formats in
+	// ConstraintFormats are checked otherwise.
+	FormatFunc func(string) error
+}
+
+// ConstraintFormats are the formats @constraint(format:) supports without registering them.
+var ConstraintFormats = map[string]func(string) error{
+	"email": func(s string) error {
+		if addr, err := mail.ParseAddress(s); err != nil || addr.Address != s {
+			return errors.New("must be a valid email address")
+		}
+		return nil
+	},
+	"url": func(s string) error {
+		if u, err := url.ParseRequestURI(s); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("must be a valid URL")
+		}
+		return nil
+	},
+	"uuid": func(s string) error {
+		if !uuidPattern.MatchString(s) {
+			return errors.New("must be a valid UUID")
+		}
+		return nil
+	},
+	"date": func(s string) error {
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return errors.New("must be a valid date")
+		}
+		return nil
+	},
+	"date-time": func(s string) error {
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return errors.New("must be a valid RFC 3339 date-time")
+		}
+		return nil
+	},
+	"ipv4": func(s string) error {
+		if addr, err := netip.ParseAddr(s); err != nil || !addr.Is4() {
+			return errors.New("must be a valid IPv4 address")
+		}
+		return nil
+	},
+	"ipv6": func(s string) error {
+		if addr, err := netip.ParseAddr(s); err != nil || !addr.Is6() {
+			return errors.New("must be a valid IPv6 address")
+		}
+		return nil
+	},
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Ptr returns a pointer to v, generated code uses it for the optional rules of constraints.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Check returns an error for every rule value breaks, on the path of ctx or of the list item
+// breaking it. Null values are not checked.
+func (c *Constraint) Check(ctx context.Context, value any) gqlerror.List {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+
+	var errs gqlerror.List
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		if c.MinLength != nil && v.Len() < *c.MinLength {
+			errs = append(errs, constraintError(ctx, "minLength", "must contain at least %d items", *c.MinLength))
+		}
+		if c.MaxLength != nil && v.Len() > *c.MaxLength {
+			errs = append(errs, constraintError(ctx, "maxLength", "must contain at most %d items", *c.MaxLength))
+		}
+		item := *c
+		item.MinLength, item.MaxLength = nil, nil
+		for i := 0; i < v.Len(); i++ {
+			ctx := WithPathContext(ctx, NewPathWithIndex(i))
+			errs = append(errs, item.Check(ctx, v.Index(i).Interface())...)
+		}
+		return errs
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		s := v.String()
+		if c.MinLength != nil && utf8.RuneCountInString(s) < *c.MinLength {
+			errs = append(errs, constraintError(ctx, "minLength", "must be at least %d characters long", *c.MinLength))
+		}
+		if c.MaxLength != nil && utf8.RuneCountInString(s) > *c.MaxLength {
+			errs = append(errs, constraintError(ctx, "maxLength", "must be at most %d characters long", *c.MaxLength))
+		}
+		if c.Pattern != nil && !c.Pattern.MatchString(s) {
+			errs = append(errs, constraintError(ctx, "pattern", "must match %s", c.Pattern))
+		}
+		if c.Format != "" {
+			check := c.FormatFunc
+			if check == nil {
+				check = ConstraintFormats[c.Format]
+			}
+			if check == nil {
+				errs = append(errs, constraintError(ctx, "format", "unknown format %s", c.Format))
+			} else if err := check(s); err != nil {
+				errs = append(errs, constraintError(ctx, "format", "%s", err.Error()))
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		errs = append(errs, c.checkNumber(ctx, float64(v.Int()))...)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		errs = append(errs, c.checkNumber(ctx, float64(v.Uint()))...)
+	case reflect.Float32, reflect.Float64:
+		errs = append(errs, c.checkNumber(ctx, v.Float())...)
+	}
+	return errs
+}
+
+func (c *Constraint) checkNumber(ctx context.Context, n float64) gqlerror.List {
+	var errs gqlerror.List
+	if c.Min != nil && n < *c.Min {
+		errs = append(errs, constraintError(ctx, "min", "must be at least %s", strconv.FormatFloat(*c.Min, 'f', -1, 64)))
+	}
+	if c.Max != nil && n > *c.Max {
+		errs = append(errs, constraintError(ctx, "max", "must be at most %s", strconv.FormatFloat(*c.Max, 'f', -1, 64)))
+	}
+	return errs
+}
+
+func constraintError(ctx context.Context, rule, format string, args ...any) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: fmt.Sprintf(format, args...),
+		Path:    GetPath(ctx),
+		Extensions: map[string]any{
+			"code":       ConstraintViolation,
+			"constraint": rule,
+		},
+	}
+}
+
+// AppendErrors adds err to errs, every error of it if it is a gqlerror.List. The errors without a
+// path get the path of ctx. Generated code uses it to report every violation of the constraints
+// of an input rather than the first.
+func AppendErrors(ctx context.Context, errs gqlerror.List, err error) gqlerror.List {
+	var list gqlerror.List
+	if !errors.As(err, &list) {
+		var gqlErr *gqlerror.Error
+		errors.As(ErrorOnPath(ctx, err), &gqlErr)
+		list = gqlerror.List{gqlErr}
+	}
+	for _, e := range list {
+		if e.Path == nil {
+			e.Path = GetPath(ctx)
+		}
+	}
+	return slices.Concat(errs, list)
+}
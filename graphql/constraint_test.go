@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestConstraintCheck(t *testing.T) {
+	ctx := WithPathContext(context.Background(), NewPathWithField("input"))
+	messages := func(errs gqlerror.List) []string {
+		var res []string
+		for _, err := range errs {
+			res = append(res, err.Path.String()+": "+err.Message)
+		}
+		return res
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		c := &Constraint{MinLength: Ptr(2), MaxLength: Ptr(4), Pattern: regexp.MustCompile(`^[a-zé]+$`)}
+		require.Empty(t, c.Check(ctx, "héé"))
+		require.Equal(t, []string{
+			"input: must be at least 2 characters long",
+		}, messages(c.Check(ctx, "a")))
+		require.Equal(t, []string{
+			"input: must be at most 4 characters long",
+			"input: must match ^[a-zé]+$",
+		}, messages(c.Check(ctx, "ABCDE")))
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		c := &Constraint{Min: Ptr[float64](1), Max: Ptr(2.5)}
+		require.Empty(t, c.Check(ctx, 2))
+		require.Empty(t, c.Check(ctx, int64(1)))
+		require.Equal(t, []string{"input: must be at least 1"}, messages(c.Check(ctx, 0)))
+		require.Equal(t, []string{"input: must be at most 2.5"}, messages(c.Check(ctx, uint(3))))
+		require.Equal(t, []string{"input: must be at most 2.5"}, messages(c.Check(ctx, 2.6)))
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		c := &Constraint{MaxLength: Ptr(2), MinLength: Ptr(1), Format: "uuid"}
+		require.Empty(t, c.Check(ctx, []string{"c5a3b9c4-5a5f-4d4e-9a5b-2d0d3c9d2e11"}))
+		require.Equal(t, []string{
+			"input: must contain at most 2 items",
+			"input[1]: must be a valid UUID",
+		}, messages(c.Check(ctx, []string{"c5a3b9c4-5a5f-4d4e-9a5b-2d0d3c9d2e11", "c5a3", "C5A3B9C4-5A5F-4D4E-9A5B-2D0D3C9D2E11"})))
+		require.Equal(t, []string{"input: must contain at least 1 items"}, messages(c.Check(ctx, []string{})))
+	})
+
+	t.Run("null values", func(t *testing.T) {
+		c := &Constraint{MinLength: Ptr(2)}
+		require.Empty(t, c.Check(ctx, nil))
+		require.Empty(t, c.Check(ctx, (*string)(nil)))
+		require.Equal(t, []string{"input: must be at least 2 characters long"}, messages(c.Check(ctx, Ptr("a"))))
+	})
+
+	t.Run("formats", func(t *testing.T) {
+		for format, values := range map[string][2]string{
+			"email":     {"ada@example.com", "Ada <ada@example.com>"},
+			"url":       {"https://example.com/a?b=c", "example.com"},
+			"uuid":      {"c5a3b9c4-5a5f-4d4e-9a5b-2d0d3c9d2e11", "c5a3b9c4"},
+			"date":      {"2024-02-29", "2023-02-29"},
+			"date-time": {"2024-02-29T10:00:00Z", "2024-02-29 10:00"},
+			"ipv4":      {"10.0.0.1", "::1"},
+			"ipv6":      {"::1", "10.0.0.1"},
+		} {
+			c := &Constraint{Format: format}
+			require.Empty(t, c.Check(ctx, values[0]), format)
+			require.Len(t, c.Check(ctx, values[1]), 1, format)
+		}
+
+		c := &Constraint{Format: "even", FormatFunc: func(s string) error {
+			if len(s)%2 != 0 {
+				return errors.New("must have an even length")
+			}
+			return nil
+		}}
+		require.Empty(t, c.Check(ctx, "ab"))
+		errs := c.Check(ctx, "abc")
+		require.Equal(t, []string{"input: must have an even length"}, messages(errs))
+		require.Equal(t, map[string]any{"code": ConstraintViolation, "constraint": "format"}, errs[0].Extensions)
+	})
+}
+
+func TestAppendErrors(t *testing.T) {
+	ctx := WithPathContext(context.Background(), NewPathWithField("input"))
+
+	errs := AppendErrors(ctx, nil, errors.New("plain"))
+	errs = AppendErrors(ctx, errs, gqlerror.List{
+		{Message: "nested", Path: ast.Path{ast.PathName("other")}},
+		{Message: "without path"},
+	})
+	require.Len(t, errs, 3)
+	require.Equal(t, "input: plain", errs[0].Path.String()+": "+errs[0].Message)
+	require.Equal(t, "other: nested", errs[1].Path.String()+": "+errs[1].Message)
+	require.Equal(t, "input: without path", errs[2].Path.String()+": "+errs[2].Message)
+}
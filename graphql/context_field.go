@@ -31,6 +31,9 @@ type FieldContext struct {
 	IsMethod bool
 	// IsResolver indicates if the field has a user-specified resolver
 	IsResolver bool
+	// Timeout is the time the resolver of the field has to return, set by @timeout. When it
+	// passes, the field resolves to null with a TIMEOUT error.
+	Timeout time.Duration
 	// Child allows getting a child FieldContext by its field collection description.
 	// Note that, the returned child FieldContext represents the context as it was
 	// before the execution of the field resolver. For example:
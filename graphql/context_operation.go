@@ -20,15 +20,24 @@ type OperationContext struct {
 	Extensions    map[string]any
 	Headers       http.Header
 
-	Operation              *ast.OperationDefinition
-	DisableIntrospection   bool
-	RecoverFunc            RecoverFunc
-	ResolverMiddleware     FieldMiddleware
-	RootResolverMiddleware RootFieldMiddleware
+	Operation            *ast.OperationDefinition
+	DisableIntrospection bool
+	// DisableErrorPropagation stops null values of non-null fields from bubbling up to the
+	// nearest nullable parent, the field is set to null and its error is reported instead.
+	DisableErrorPropagation bool
+	RecoverFunc             RecoverFunc
+	ResolverMiddleware      FieldMiddleware
+	RootResolverMiddleware  RootFieldMiddleware
+	// ExecutableSchema is the schema the operation is executed against, Lookahead reads the
+	// possible types of abstract fields and the Go fields of models from it.
+	ExecutableSchema ExecutableSchema
 
 	Stats Stats
 
 	collectFieldsCache collectFieldsCacheStore
+	// decodedInputs holds the input objects of Variables read by generated input decoders, by
+	// the maps they are read into.
+	decodedInputs map[uintptr]*DecodedInput
 }
 
 func (c *OperationContext) Validate(ctx context.Context) error {
@@ -80,6 +89,17 @@ func HasOperationContext(ctx context.Context) bool {
 	return ok && val != nil
 }
 
+// OperationSchema returns the executable schema opCtx is executed against, or fallback when it
+// has none, like operation contexts built by hand. Extensions read the schema of each operation
+// with it rather than keep the one they were validated with, so they can be shared by the
+// versions of a handler.Router.
+func OperationSchema(opCtx *OperationContext, fallback ExecutableSchema) ExecutableSchema {
+	if opCtx != nil && opCtx.ExecutableSchema != nil {
+		return opCtx.ExecutableSchema
+	}
+	return fallback
+}
+
 // CollectFieldsCtx is just a convenient wrapper method for CollectFields.
 func CollectFieldsCtx(ctx context.Context, satisfies []string) []CollectedField {
 	resctx := GetFieldContext(ctx)
@@ -59,6 +59,15 @@ func TestGetOperationContext(t *testing.T) {
 	})
 }
 
+func TestOperationSchema(t *testing.T) {
+	fallback := &ExecutableSchemaMock{}
+	es := &ExecutableSchemaMock{}
+
+	require.Same(t, es, OperationSchema(&OperationContext{ExecutableSchema: es}, fallback))
+	require.Same(t, fallback, OperationSchema(&OperationContext{}, fallback))
+	require.Same(t, fallback, OperationSchema(nil, fallback))
+}
+
 func TestCollectFields(t *testing.T) {
 	getNames := func(collected []CollectedField) []string {
 		names := make([]string, 0, len(collected))
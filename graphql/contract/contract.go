@@ -0,0 +1,341 @@
+// Package contract filters a schema down to a contract variant, keeping only the types and
+// fields whose @tag(name:) directives the contract accepts.
+//
+// A filtered schema is served by creating an executable schema with it, eg with the Schema of
+// the generated Config. Requests are then validated against the variant and introspection
+// returns the variant.
+package contract
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Contract selects the elements of a schema by their @tag(name:) directives.
+type Contract struct {
+	// Include keeps only the fields tagged with one of these tags, or belonging to a type tagged
+	// with one of them. Every field is kept when it is empty.
+	Include []string
+	// Exclude removes the types, fields, arguments, input fields and enum values tagged with one
+	// of these tags. It wins over Include.
+	Exclude []string
+}
+
+// Filter returns a copy of schema with the elements of the contract. Types that can no longer
+// be reached from the root operation types are removed as well. It fails when the variant is
+// not a valid schema, eg when it removes a required argument or every field of Query.
+func (c Contract) Filter(schema *ast.Schema) (*ast.Schema, error) {
+	f := filter{contract: c, schema: schema, types: map[string]*ast.Definition{}}
+	for name, def := range schema.Types {
+		if isBuiltIn(def.Name) || !c.excludes(def.Directives) {
+			f.types[name] = f.copyDefinition(def)
+		}
+	}
+
+	// removing a type removes the fields of its type, which may leave other types empty
+	for changed := true; changed; {
+		var err error
+		changed, err = f.removeDangling()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := f.checkRequired(); err != nil {
+		return nil, err
+	}
+	f.removeUnreachable()
+
+	return f.build()
+}
+
+// Tags returns the names of the @tag directives of directives.
+func Tags(directives ast.DirectiveList) []string {
+	var tags []string
+	for _, d := range directives.ForNames("tag") {
+		if arg := d.Arguments.ForName("name"); arg != nil && arg.Value != nil {
+			tags = append(tags, arg.Value.Raw)
+		}
+	}
+	return tags
+}
+
+func (c Contract) excludes(directives ast.DirectiveList) bool {
+	for _, tag := range Tags(directives) {
+		if slices.Contains(c.Exclude, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Contract) includes(directives ast.DirectiveList) bool {
+	for _, tag := range Tags(directives) {
+		if slices.Contains(c.Include, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+type filter struct {
+	contract Contract
+	schema   *ast.Schema
+	types    map[string]*ast.Definition
+}
+
+// copyDefinition copies def without its excluded members, the other definitions are left
+// untouched.
+func (f *filter) copyDefinition(def *ast.Definition) *ast.Definition {
+	ret := *def
+	if isBuiltIn(def.Name) {
+		return &ret
+	}
+
+	ret.Fields = nil
+	includeAll := len(f.contract.Include) == 0 || def.Kind == ast.InputObject || f.contract.includes(def.Directives)
+	for _, field := range def.Fields {
+		if f.contract.excludes(field.Directives) {
+			continue
+		}
+		if !includeAll && !strings.HasPrefix(field.Name, "__") && !f.contract.includes(field.Directives) {
+			continue
+		}
+		copied := *field
+		copied.Arguments = nil
+		for _, arg := range field.Arguments {
+			if !f.contract.excludes(arg.Directives) {
+				copied.Arguments = append(copied.Arguments, arg)
+			}
+		}
+		ret.Fields = append(ret.Fields, &copied)
+	}
+
+	ret.EnumValues = nil
+	for _, value := range def.EnumValues {
+		if !f.contract.excludes(value.Directives) {
+			ret.EnumValues = append(ret.EnumValues, value)
+		}
+	}
+	return &ret
+}
+
+// removeDangling removes the members referencing removed types and the types left empty. It
+// reports whether it removed anything.
+func (f *filter) removeDangling() (bool, error) {
+	changed := false
+	for name, def := range f.types {
+		if isBuiltIn(name) {
+			continue
+		}
+
+		fields := def.Fields[:0:0]
+		for _, field := range def.Fields {
+			if f.types[field.Type.Name()] == nil {
+				if def.Kind == ast.InputObject && field.Type.NonNull && field.DefaultValue == nil {
+					return false, fmt.Errorf("%s.%s is required but its type %s is not in the contract", name, field.Name, field.Type.Name())
+				}
+				continue
+			}
+			args := field.Arguments[:0:0]
+			for _, arg := range field.Arguments {
+				if f.types[arg.Type.Name()] != nil {
+					args = append(args, arg)
+				} else if arg.Type.NonNull && arg.DefaultValue == nil {
+					return false, fmt.Errorf("%s.%s(%s:) is required but its type %s is not in the contract", name, field.Name, arg.Name, arg.Type.Name())
+				}
+			}
+			field.Arguments = args
+			fields = append(fields, field)
+		}
+		if len(fields) != len(def.Fields) {
+			def.Fields = fields
+			changed = true
+		}
+
+		members := slices.DeleteFunc(slices.Clone(def.Types), func(member string) bool { return f.types[member] == nil })
+		if len(members) != len(def.Types) {
+			def.Types = members
+			changed = true
+		}
+		interfaces := slices.DeleteFunc(slices.Clone(def.Interfaces), func(i string) bool { return f.types[i] == nil })
+		if len(interfaces) != len(def.Interfaces) {
+			def.Interfaces = interfaces
+			changed = true
+		}
+
+		var empty bool
+		switch def.Kind {
+		case ast.Object, ast.Interface, ast.InputObject:
+			empty = !slices.ContainsFunc(def.Fields, func(field *ast.FieldDefinition) bool {
+				return !strings.HasPrefix(field.Name, "__")
+			})
+		case ast.Union:
+			empty = len(def.Types) == 0
+		case ast.Enum:
+			empty = len(def.EnumValues) == 0
+		}
+		if empty {
+			if f.schema.Query != nil && name == f.schema.Query.Name {
+				return false, fmt.Errorf("the contract removes every field of %s", name)
+			}
+			delete(f.types, name)
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// checkRequired fails when the contract removes a required argument or input field, clients of
+// the variant could not send them.
+func (f *filter) checkRequired() error {
+	for name, def := range f.schema.Types {
+		kept := f.types[name]
+		if kept == nil || isBuiltIn(name) {
+			continue
+		}
+		for _, field := range def.Fields {
+			keptField := kept.Fields.ForName(field.Name)
+			if keptField == nil {
+				if def.Kind == ast.InputObject && field.Type.NonNull && field.DefaultValue == nil {
+					return fmt.Errorf("%s.%s is required but not in the contract", name, field.Name)
+				}
+				continue
+			}
+			for _, arg := range field.Arguments {
+				if keptField.Arguments.ForName(arg.Name) == nil && arg.Type.NonNull && arg.DefaultValue == nil {
+					return fmt.Errorf("%s.%s(%s:) is required but not in the contract", name, field.Name, arg.Name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isBuiltIn reports whether name is an introspection type or a scalar of the spec, they are kept
+// whatever the contract. The BuiltIn flag of definitions is not used as it is set on every type
+// of the schemas generated code loads from a single built in source.
+func isBuiltIn(name string) bool {
+	switch name {
+	case "String", "Int", "Float", "Boolean", "ID":
+		return true
+	}
+	return strings.HasPrefix(name, "__")
+}
+
+func (f *filter) isRoot(name string) bool {
+	for _, root := range []*ast.Definition{f.schema.Query, f.schema.Mutation, f.schema.Subscription} {
+		if root != nil && root.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// removeUnreachable removes the types that cannot be reached from the root operation types, the
+// built in types and the arguments of directives.
+func (f *filter) removeUnreachable() {
+	reachable := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		def := f.types[name]
+		if def == nil || reachable[name] {
+			return
+		}
+		reachable[name] = true
+		for _, field := range def.Fields {
+			visit(field.Type.Name())
+			for _, arg := range field.Arguments {
+				visit(arg.Type.Name())
+			}
+		}
+		for _, member := range def.Types {
+			visit(member)
+		}
+		for _, i := range def.Interfaces {
+			visit(i)
+		}
+		if def.Kind == ast.Interface {
+			for _, impl := range f.types {
+				if slices.Contains(impl.Interfaces, name) {
+					visit(impl.Name)
+				}
+			}
+		}
+	}
+
+	for name := range f.types {
+		if isBuiltIn(name) || f.isRoot(name) {
+			visit(name)
+		}
+	}
+	for _, directive := range f.schema.Directives {
+		for _, arg := range directive.Arguments {
+			visit(arg.Type.Name())
+		}
+	}
+
+	for name := range f.types {
+		if !reachable[name] {
+			delete(f.types, name)
+		}
+	}
+}
+
+// build assembles the filtered schema and checks the objects still implement their
+// interfaces.
+func (f *filter) build() (*ast.Schema, error) {
+	ret := *f.schema
+	ret.Types = f.types
+	ret.Query = f.root(f.schema.Query)
+	ret.Mutation = f.root(f.schema.Mutation)
+	ret.Subscription = f.root(f.schema.Subscription)
+	ret.PossibleTypes = map[string][]*ast.Definition{}
+	ret.Implements = map[string][]*ast.Definition{}
+
+	names := make([]string, 0, len(f.types))
+	for name := range f.types {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		def := f.types[name]
+		switch def.Kind {
+		case ast.Object, ast.Interface:
+			for _, i := range def.Interfaces {
+				iface := f.types[i]
+				for _, field := range iface.Fields {
+					if def.Fields.ForName(field.Name) == nil {
+						return nil, fmt.Errorf("%s implements %s but the contract removes its field %s", name, i, field.Name)
+					}
+				}
+				ret.AddPossibleType(i, def)
+				ret.AddImplements(name, iface)
+			}
+			if def.Kind == ast.Object {
+				ret.AddPossibleType(name, def)
+			}
+		case ast.InputObject:
+			ret.AddPossibleType(name, def)
+		case ast.Union:
+			for _, member := range def.Types {
+				ret.AddPossibleType(name, f.types[member])
+				ret.AddImplements(member, def)
+			}
+		}
+	}
+
+	return &ret, nil
+}
+
+func (f *filter) root(def *ast.Definition) *ast.Definition {
+	if def == nil {
+		return nil
+	}
+	return f.types[def.Name]
+}
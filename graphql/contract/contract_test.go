@@ -0,0 +1,102 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const testSchema = `
+	directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
+
+	type Query {
+		user(id: ID!, debug: Boolean @tag(name: "internal")): User @tag(name: "public")
+		search(filter: Filter): [Result!]! @tag(name: "public")
+		audit: [AuditEntry!]! @tag(name: "internal")
+	}
+
+	type Mutation {
+		ban(id: ID!): Boolean @tag(name: "internal")
+	}
+
+	interface Node { id: ID! }
+
+	type User implements Node @tag(name: "public") {
+		id: ID!
+		name: String!
+		email: String @tag(name: "internal")
+		role: Role
+	}
+
+	type AuditEntry implements Node @tag(name: "internal") {
+		id: ID!
+		user: User
+	}
+
+	union Result = User | AuditEntry
+
+	enum Role { ADMIN @tag(name: "internal") MEMBER }
+
+	input Filter {
+		name: String
+		includeBanned: Boolean @tag(name: "internal")
+	}
+`
+
+func TestFilter(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+
+	t.Run("exclude", func(t *testing.T) {
+		public, err := Contract{Exclude: []string{"internal"}}.Filter(schema)
+		require.NoError(t, err)
+
+		require.Nil(t, public.Types["AuditEntry"])
+		require.Nil(t, public.Mutation)
+		require.Nil(t, public.Types["Mutation"])
+		require.Nil(t, public.Query.Fields.ForName("audit"))
+		require.Len(t, public.Query.Fields.ForName("user").Arguments, 1)
+		require.Nil(t, public.Types["User"].Fields.ForName("email"))
+		require.Equal(t, []string{"User"}, public.Types["Result"].Types)
+		require.Len(t, public.Types["Role"].EnumValues, 1)
+		require.Nil(t, public.Types["Filter"].Fields.ForName("includeBanned"))
+		require.Same(t, public.Types["Query"], public.Query)
+		require.Equal(t, []*ast.Definition{public.Types["User"]}, public.GetPossibleTypes(public.Types["Node"]))
+
+		// the schema itself is left untouched
+		require.NotNil(t, schema.Types["AuditEntry"])
+		require.NotNil(t, schema.Types["User"].Fields.ForName("email"))
+		require.Len(t, schema.Query.Fields.ForName("user").Arguments, 2)
+	})
+
+	t.Run("include", func(t *testing.T) {
+		public, err := Contract{Include: []string{"public"}}.Filter(schema)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"user", "search", "__schema", "__type"}, fieldNames(public.Query))
+		require.Equal(t, []string{"id", "name", "email", "role"}, fieldNames(public.Types["User"]))
+		require.Nil(t, public.Mutation)
+		require.Nil(t, public.Types["AuditEntry"], "none of its fields are included")
+		require.Equal(t, []string{"User"}, public.Types["Result"].Types)
+	})
+
+	t.Run("invalid variants", func(t *testing.T) {
+		_, err := Contract{Include: []string{"unknown"}}.Filter(schema)
+		require.EqualError(t, err, "the contract removes every field of Query")
+
+		_, err = Contract{Exclude: []string{"public"}}.Filter(gqlparser.MustLoadSchema(&ast.Source{Input: `
+			directive @tag(name: String!) repeatable on ARGUMENT_DEFINITION
+			type Query { user(id: ID! @tag(name: "public")): String, name: String }
+		`}))
+		require.EqualError(t, err, "Query.user(id:) is required but not in the contract")
+	})
+}
+
+func fieldNames(def *ast.Definition) []string {
+	var names []string
+	for _, field := range def.Fields {
+		names = append(names, field.Name)
+	}
+	return names
+}
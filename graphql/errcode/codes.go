@@ -7,6 +7,10 @@ import (
 const (
 	ValidationFailed = "GRAPHQL_VALIDATION_FAILED"
 	ParseFailed      = "GRAPHQL_PARSE_FAILED"
+	// Timeout is the code of the errors of fields whose resolver did not return in time.
+	Timeout = "TIMEOUT"
+	// Internal is the code of the errors that are not meant for clients.
+	Internal = "INTERNAL_SERVER_ERROR"
 )
 
 type ErrorKind int
@@ -21,6 +25,8 @@ const (
 var codeType = map[string]ErrorKind{
 	ValidationFailed: KindProtocol,
 	ParseFailed:      KindProtocol,
+	Timeout:          KindUser,
+	Internal:         KindUser,
 }
 
 // RegisterErrorType should be called by extensions that want to customize the http status codes for
@@ -29,6 +35,26 @@ func RegisterErrorType(code string, kind ErrorKind) {
 	codeType[code] = kind
 }
 
+// publicCodes are the codes of the errors meant for clients, which masking error presenters do not
+// hide.
+var publicCodes = map[string]bool{
+	ValidationFailed: true,
+	ParseFailed:      true,
+	Timeout:          true,
+}
+
+// RegisterPublicCode should be called by extensions returning errors meant for clients, so
+// masking error presenters do not hide them. Call it from an init function: the codes are read
+// without locking while requests are served.
+func RegisterPublicCode(code string) {
+	publicCodes[code] = true
+}
+
+// IsPublicCode reports whether the errors with code are meant for clients.
+func IsPublicCode(code string) bool {
+	return publicCodes[code]
+}
+
 // Set the error code on a given graphql error extension
 func Set(err error, value string) {
 	if err == nil {
@@ -0,0 +1,147 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// PublicError is implemented by errors whose message can be shown to clients, ErrorMasking
+// presents them as is.
+type PublicError interface {
+	error
+	PublicError()
+}
+
+type publicError struct {
+	error
+}
+
+func (publicError) PublicError() {}
+
+func (e publicError) Unwrap() error {
+	return e.error
+}
+
+// Public returns err marked as a PublicError.
+func Public(err error) error {
+	if err == nil {
+		return nil
+	}
+	return publicError{err}
+}
+
+// PanicError is the error returned by RecoverPanic, it keeps the value and the stack of a panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// RecoverPanic is a RecoverFunc returning a PanicError, so an ErrorMasking Sink gets the value and
+// the stack of the panic while clients get a masked error.
+func RecoverPanic(ctx context.Context, err any) error {
+	return &PanicError{Value: err, Stack: debug.Stack()}
+}
+
+// ErrorIncident is a masked error, passed to the ErrorMasking Sink.
+type ErrorIncident struct {
+	// ID is the incident ID sent to the client in the incidentId extension of the error.
+	ID string
+	// Err is the original error.
+	Err error
+	// Path is the path of the field of the error, if any.
+	Path ast.Path
+	// Operation is the operation of the error, nil when it was raised before the operation was
+	// created.
+	Operation *OperationContext
+}
+
+// ErrorMasking presents errors without leaking their messages to clients. Errors are presented as
+// is when they:
+//
+//   - implement PublicError, see Public;
+//   - have one of AllowedCodes or a code registered with errcode.RegisterPublicCode, such as the
+//     codes of parse and validation failures, timeouts and the errors of the APQ and complexity
+//     extensions;
+//   - are raised by transports before an operation is created, like undecodable requests, unless
+//     they are PanicError.
+//
+// Other errors are replaced by Message with the errcode.Internal code and a generated incident ID
+// in the incidentId extension, and are passed to Sink to be logged with that ID.
+//
+//	masking := &graphql.ErrorMasking{Sink: logIncident}
+//	srv.SetErrorPresenter(masking.Present)
+//	srv.SetRecoverFunc(graphql.RecoverPanic)
+type ErrorMasking struct {
+	// AllowedCodes are the errcode codes of the errors presented as is.
+	AllowedCodes []string
+	// Message replaces the messages of masked errors, "internal system error" when empty.
+	Message string
+	// Sink is called with every masked error.
+	Sink func(ctx context.Context, incident ErrorIncident)
+}
+
+var _ ErrorPresenterFunc = (&ErrorMasking{}).Present
+
+// Present is an ErrorPresenterFunc masking the errors that are not public.
+func (m *ErrorMasking) Present(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := DefaultErrorPresenter(ctx, err)
+	if gqlErr == nil || m.isPublic(ctx, err, gqlErr) {
+		return gqlErr
+	}
+
+	incident := ErrorIncident{
+		ID:   rand.Text(),
+		Err:  err,
+		Path: gqlErr.Path,
+	}
+	if HasOperationContext(ctx) {
+		incident.Operation = GetOperationContext(ctx)
+	}
+	if m.Sink != nil {
+		m.Sink(ctx, incident)
+	}
+
+	message := m.Message
+	if message == "" {
+		message = "internal system error"
+	}
+	return &gqlerror.Error{
+		Message:   message,
+		Path:      gqlErr.Path,
+		Locations: gqlErr.Locations,
+		Extensions: map[string]any{
+			"code":       errcode.Internal,
+			"incidentId": incident.ID,
+		},
+	}
+}
+
+func (m *ErrorMasking) isPublic(ctx context.Context, err error, gqlErr *gqlerror.Error) bool {
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		return false
+	}
+	var public PublicError
+	if errors.As(err, &public) {
+		return true
+	}
+	if code, ok := gqlErr.Extensions["code"].(string); ok {
+		if errcode.IsPublicCode(code) || slices.Contains(m.AllowedCodes, code) {
+			return true
+		}
+	}
+	return !HasOperationContext(ctx)
+}
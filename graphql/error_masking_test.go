@@ -0,0 +1,93 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+func TestErrorMasking(t *testing.T) {
+	opCtx := &OperationContext{OperationName: "Users"}
+	ctx := WithOperationContext(context.Background(), opCtx)
+	ctx = WithFieldContext(ctx, &FieldContext{Field: CollectedField{Field: &ast.Field{Alias: "users"}}})
+
+	var incidents []ErrorIncident
+	masking := &ErrorMasking{
+		AllowedCodes: []string{"UNAUTHENTICATED"},
+		Sink: func(ctx context.Context, incident ErrorIncident) {
+			incidents = append(incidents, incident)
+		},
+	}
+	present := func(ctx context.Context, err error) *gqlerror.Error {
+		incidents = nil
+		return masking.Present(ctx, err)
+	}
+
+	t.Run("masks errors", func(t *testing.T) {
+		err := errors.New("pq: password authentication failed")
+		gqlErr := present(ctx, err)
+
+		require.Len(t, incidents, 1)
+		require.NotEmpty(t, incidents[0].ID)
+		require.Equal(t, ErrorIncident{
+			ID:        incidents[0].ID,
+			Err:       err,
+			Path:      ast.Path{ast.PathName("users")},
+			Operation: opCtx,
+		}, incidents[0])
+		require.Equal(t, &gqlerror.Error{
+			Message:    "internal system error",
+			Path:       ast.Path{ast.PathName("users")},
+			Extensions: map[string]any{"code": errcode.Internal, "incidentId": incidents[0].ID},
+		}, gqlErr)
+	})
+
+	t.Run("masks panics", func(t *testing.T) {
+		err := RecoverPanic(ctx, "boom")
+		gqlErr := present(context.Background(), err)
+
+		require.Equal(t, "internal system error", gqlErr.Message)
+		require.Len(t, incidents, 1)
+		var panicErr *PanicError
+		require.ErrorAs(t, incidents[0].Err, &panicErr)
+		require.Equal(t, "boom", panicErr.Value)
+		require.NotEmpty(t, panicErr.Stack)
+		require.Nil(t, incidents[0].Operation)
+	})
+
+	t.Run("presents public errors", func(t *testing.T) {
+		gqlErr := present(ctx, fmt.Errorf("users: %w", Public(errors.New("user not found"))))
+
+		require.Empty(t, incidents)
+		require.Equal(t, "users: user not found", gqlErr.Message)
+	})
+
+	t.Run("presents errors with public codes", func(t *testing.T) {
+		for _, code := range []string{errcode.ValidationFailed, errcode.Timeout, "UNAUTHENTICATED"} {
+			err := gqlerror.Errorf("not allowed")
+			errcode.Set(err, code)
+
+			require.Equal(t, err, present(ctx, err))
+			require.Empty(t, incidents)
+		}
+	})
+
+	t.Run("presents transport errors", func(t *testing.T) {
+		err := gqlerror.Errorf("json request body could not be decoded")
+
+		require.Equal(t, err, present(context.Background(), err))
+		require.Empty(t, incidents)
+	})
+
+	t.Run("custom message", func(t *testing.T) {
+		masking := &ErrorMasking{Message: "something went wrong"}
+		require.Equal(t, "something went wrong", masking.Present(ctx, errors.New("boom")).Message)
+	})
+}
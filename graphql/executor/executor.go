@@ -1,7 +1,10 @@
 package executor
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -15,6 +18,13 @@ import (
 
 const parserTokenNoLimit = 0
 
+const (
+	// disableErrorPropagationExtension is the request extension turning off null propagation.
+	disableErrorPropagationExtension = "disableErrorPropagation"
+	// disableErrorPropagationDirective is the operation directive turning off null propagation.
+	disableErrorPropagationDirective = "experimental_disableErrorPropagation"
+)
+
 // Executor executes graphql queries against a schema.
 type Executor struct {
 	es         graphql.ExecutableSchema
@@ -28,9 +38,13 @@ type Executor struct {
 	parserTokenLimit  int
 	disableSuggestion bool
 	defaultRulesFn    func() *rules.Rules
+	operationTimeout  time.Duration
 }
 
-var _ graphql.GraphExecutor = &Executor{}
+var (
+	_ graphql.GraphExecutor        = &Executor{}
+	_ graphql.RawVariablesExecutor = &Executor{}
+)
 
 // New creates a new Executor with the given schema, and a default error and
 // recovery callbacks, and no query cache or extensions.
@@ -60,6 +74,7 @@ func (e *Executor) CreateOperationContext(
 		RecoverFunc:            e.recoverFunc,
 		ResolverMiddleware:     e.ext.fieldMiddleware,
 		RootResolverMiddleware: e.ext.rootFieldMiddleware,
+		ExecutableSchema:       e.es,
 		Stats: graphql.Stats{
 			Read:           params.ReadTime,
 			OperationStart: graphql.GetStartTime(ctx),
@@ -90,13 +105,19 @@ func (e *Executor) CreateOperationContext(
 		errcode.Set(err, errcode.ValidationFailed)
 		return opCtx, gqlerror.List{err}
 	}
+	opCtx.DisableErrorPropagation = params.Extensions[disableErrorPropagationExtension] == true ||
+		opCtx.Operation.Directives.ForName(disableErrorPropagationDirective) != nil
 
 	var err error
-	opCtx.Variables, err = validator.VariableValues(
-		e.es.Schema(),
-		opCtx.Operation,
-		params.Variables,
-	)
+	if params.Variables == nil && params.RawVariables != nil {
+		opCtx.Variables, err = e.decodeVariables(ctx, opCtx.Operation, params.RawVariables)
+	} else {
+		opCtx.Variables, err = validator.VariableValues(
+			e.es.Schema(),
+			opCtx.Operation,
+			params.Variables,
+		)
+	}
 	if err != nil {
 		gqlErr, ok := err.(*gqlerror.Error)
 		if ok {
@@ -115,6 +136,31 @@ func (e *Executor) CreateOperationContext(
 	return opCtx, nil
 }
 
+// DecodesRawVariables reports whether the schema decodes the variables of operations from
+// RawParams.RawVariables itself, it implements graphql.RawVariablesExecutor.
+func (e *Executor) DecodesRawVariables() bool {
+	_, ok := e.es.(graphql.VariablesDecoder)
+	return ok
+}
+
+func (e *Executor) decodeVariables(
+	ctx context.Context,
+	op *ast.OperationDefinition,
+	raw json.RawMessage,
+) (map[string]any, error) {
+	if d, ok := e.es.(graphql.VariablesDecoder); ok {
+		return d.DecodeVariables(ctx, op, raw)
+	}
+
+	var vars map[string]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&vars); err != nil {
+		return nil, gqlerror.Errorf("variables could not be decoded: %s", err)
+	}
+	return validator.VariableValues(e.es.Schema(), op, vars)
+}
+
 func (e *Executor) DispatchOperation(
 	ctx context.Context,
 	opCtx *graphql.OperationContext,
@@ -132,6 +178,11 @@ func (e *Executor) DispatchOperation(
 
 		return func(ctx context.Context) *graphql.Response {
 			ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+			if e.operationTimeout > 0 && opCtx.Operation.Operation != ast.Subscription {
+				var cancel context.CancelFunc
+				ctx, cancel = graphql.WithOperationTimeout(ctx, e.operationTimeout)
+				defer cancel()
+			}
 			resp := e.ext.responseMiddleware(ctx, func(ctx context.Context) *graphql.Response {
 				resp := responses(ctx)
 				if resp == nil {
@@ -193,6 +244,13 @@ func (e *Executor) SetDisableSuggestion(value bool) {
 	e.disableSuggestion = value
 }
 
+// SetOperationTimeout sets the time queries and mutations have to resolve their fields with a
+// method or a resolver, those still resolving when it passes resolve to null with a TIMEOUT
+// error. Fields with a @timeout of their own keep it.
+func (e *Executor) SetOperationTimeout(timeout time.Duration) {
+	e.operationTimeout = timeout
+}
+
 // parseQuery decodes the incoming query and validates it, pulling from cache if present.
 //
 // NOTE: This should NOT look at variables, they will change per request. It should only parse and
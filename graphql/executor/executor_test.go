@@ -2,6 +2,7 @@ package executor_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -183,6 +184,61 @@ func TestExecutor(t *testing.T) {
 	})
 }
 
+func TestExecutorDisableErrorPropagation(t *testing.T) {
+	exec := testexecutor.New()
+	ctx := graphql.StartOperationTrace(context.Background())
+
+	t.Run("propagates errors by default", func(t *testing.T) {
+		opCtx, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "{name}"})
+		require.Empty(t, errs)
+		assert.False(t, opCtx.DisableErrorPropagation)
+	})
+
+	t.Run("disabled by the request extension", func(t *testing.T) {
+		opCtx, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{
+			Query:      "{name}",
+			Extensions: map[string]any{"disableErrorPropagation": true},
+		})
+		require.Empty(t, errs)
+		assert.True(t, opCtx.DisableErrorPropagation)
+	})
+}
+
+func TestExecutorRawVariables(t *testing.T) {
+	exec := testexecutor.New()
+	ctx := graphql.StartOperationTrace(context.Background())
+
+	t.Run("schemas without decoders do not decode raw variables", func(t *testing.T) {
+		assert.False(t, exec.DecodesRawVariables())
+	})
+
+	t.Run("raw variables are decoded and validated", func(t *testing.T) {
+		opCtx, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{
+			Query:        "query($id: Int!) { find(id: $id) }",
+			RawVariables: json.RawMessage(`{"id": 1}`),
+		})
+		require.Empty(t, errs)
+		assert.Equal(t, map[string]any{"id": int64(1)}, opCtx.Variables)
+
+		_, errs = exec.CreateOperationContext(ctx, &graphql.RawParams{
+			Query:        "query($id: Int!) { find(id: $id) }",
+			RawVariables: json.RawMessage(`{"id": null}`),
+		})
+		require.Len(t, errs, 1)
+		assert.Equal(t, "input: variable.id cannot be null", errs[0].Error())
+	})
+
+	t.Run("variables take precedence", func(t *testing.T) {
+		opCtx, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{
+			Query:        "query($id: Int!) { find(id: $id) }",
+			Variables:    map[string]any{"id": 2},
+			RawVariables: json.RawMessage(`{"id": 1}`),
+		})
+		require.Empty(t, errs)
+		assert.Equal(t, map[string]any{"id": 2}, opCtx.Variables)
+	})
+}
+
 func TestExecutorDisableSuggestion(t *testing.T) {
 	t.Run("by default, the error message will include suggestions", func(t *testing.T) {
 		exec := testexecutor.New()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"sync"
+	"sync/atomic"
 )
 
 type FieldSet struct {
@@ -25,6 +26,18 @@ func NewFieldSet(fields []CollectedField) *FieldSet {
 	}
 }
 
+// IsNull reports whether the object of the field set is null because one of its non-null fields
+// is, unless the operation disables error propagation.
+func (m *FieldSet) IsNull(ctx context.Context) bool {
+	return atomic.LoadUint32(&m.Invalids) > 0 && PropagatesErrors(ctx)
+}
+
+// PropagatesErrors reports whether null values of non-null fields bubble up to their parents in
+// the operation of ctx.
+func PropagatesErrors(ctx context.Context) bool {
+	return !HasOperationContext(ctx) || !GetOperationContext(ctx).DisableErrorPropagation
+}
+
 func (m *FieldSet) AddField(field CollectedField) {
 	m.fields = append(m.fields, field)
 	m.Values = append(m.Values, nil)
@@ -57,11 +70,23 @@ func (m *FieldSet) Dispatch(ctx context.Context) {
 	}
 }
 
+// smallFieldSet is the number of fields up to which duplicated aliases are found by comparing
+// them, rather than by tracking them in a map.
+const smallFieldSet = 32
+
 func (m *FieldSet) MarshalGQL(writer io.Writer) {
 	writer.Write(openBrace)
-	writtenFields := make(map[string]bool, len(m.fields))
+	var writtenFields map[string]bool
+	if len(m.fields) > smallFieldSet {
+		writtenFields = make(map[string]bool, len(m.fields))
+	}
 	for i, field := range m.fields {
-		if writtenFields[field.Alias] {
+		if writtenFields != nil {
+			if writtenFields[field.Alias] {
+				continue
+			}
+			writtenFields[field.Alias] = true
+		} else if m.written(i) {
 			continue
 		}
 		if i != 0 {
@@ -70,7 +95,16 @@ func (m *FieldSet) MarshalGQL(writer io.Writer) {
 		writeQuotedString(writer, field.Alias)
 		writer.Write(colon)
 		m.Values[i].MarshalGQL(writer)
-		writtenFields[field.Alias] = true
 	}
 	writer.Write(closeBrace)
 }
+
+// written reports whether a field before the field at i has its alias.
+func (m *FieldSet) written(i int) bool {
+	for _, field := range m.fields[:i] {
+		if field.Alias == m.fields[i].Alias {
+			return true
+		}
+	}
+	return false
+}
@@ -12,7 +12,7 @@ import (
 
 func MarshalFloat(f float64) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		fmt.Fprintf(w, "%g", f)
+		writeFloat(w, f)
 	})
 }
 
@@ -40,7 +40,7 @@ func MarshalFloatContext(f float64) ContextMarshaler {
 		if math.IsInf(f, 0) || math.IsNaN(f) {
 			return errors.New("cannot marshal infinite no NaN float values")
 		}
-		fmt.Fprintf(w, "%g", f)
+		writeFloat(w, f)
 		return nil
 	})
 }
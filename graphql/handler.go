@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"strings"
@@ -29,6 +30,11 @@ type (
 		Extensions    map[string]any `json:"extensions"`
 		Headers       http.Header    `json:"headers"`
 
+		// RawVariables holds the variables as sent, left undecoded by the transports when the
+		// executor reports it DecodesRawVariables. They are only read when Variables is nil, so
+		// OperationParameterMutators replacing the variables should set Variables.
+		RawVariables json.RawMessage `json:"-"`
+
 		ReadTime TraceTiming `json:"-"`
 	}
 
@@ -44,6 +50,13 @@ type (
 		DispatchError(ctx context.Context, list gqlerror.List) *Response
 	}
 
+	// RawVariablesExecutor is implemented by executors that can decode RawParams.RawVariables
+	// once the operation is known, DecodesRawVariables reports whether they do so for the schema
+	// they execute.
+	RawVariablesExecutor interface {
+		DecodesRawVariables() bool
+	}
+
 	// HandlerExtension adds functionality to the http handler. See the list of possible hook points
 	// below Its important to understand the lifecycle of a graphql request and the terminology we
 	// use in gqlgen
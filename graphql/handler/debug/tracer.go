@@ -13,12 +13,18 @@ import (
 	"github.com/mattn/go-isatty"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 )
 
 type Tracer struct {
 	DisableColor bool
-	au           *aurora.Aurora
-	out          io.Writer
+	// Redactor masks the sensitive variables before they are printed, they are printed as is
+	// when nil.
+	Redactor *extension.Redactor
+
+	au  *aurora.Aurora
+	out io.Writer
+	es  graphql.ExecutableSchema
 }
 
 var _ interface {
@@ -35,6 +41,7 @@ func (a *Tracer) Validate(schema graphql.ExecutableSchema) error {
 
 	a.au = aurora.New(aurora.WithColors(!a.DisableColor && isTTY))
 	a.out = colorable.NewColorableStdout()
+	a.es = schema
 
 	return nil
 }
@@ -58,7 +65,12 @@ func (a Tracer) InterceptResponse(
 	for _, line := range strings.Split(opCtx.RawQuery, "\n") {
 		_, _ = fmt.Fprintln(a.out, " ", aurora.Cyan(line))
 	}
-	for name, value := range opCtx.Variables {
+	variables := opCtx.Variables
+	if a.Redactor != nil {
+		schema := graphql.OperationSchema(opCtx, a.es).Schema()
+		variables = a.Redactor.RedactVariables(schema, opCtx.Operation, variables)
+	}
+	for name, value := range variables {
 		_, _ = fmt.Fprintf(a.out, "  var %s = %s\n", name, aurora.Yellow(stringify(value)))
 	}
 	resp := next(ctx)
@@ -18,6 +18,10 @@ const (
 	errPersistedQueryNotFoundCode = "PERSISTED_QUERY_NOT_FOUND"
 )
 
+func init() {
+	errcode.RegisterPublicCode(errPersistedQueryNotFoundCode)
+}
+
 // AutomaticPersistedQuery saves client upload by optimistically sending only the hashes of queries,
 // if the server does not yet know what the query is for the hash it will respond telling the client
 // to send the query along with the
@@ -13,6 +13,10 @@ import (
 
 const errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
 
+func init() {
+	errcode.RegisterPublicCode(errComplexityLimit)
+}
+
 // ComplexityLimit allows you to define a limit on query complexity
 //
 // If a query is submitted that exceeds the limit, a 422 status code will be returned.
@@ -65,7 +69,8 @@ func (c ComplexityLimit) MutateOperationContext(
 	opCtx *graphql.OperationContext,
 ) *gqlerror.Error {
 	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
-	complexityCalcs := complexity.Calculate(ctx, c.es, op, opCtx.Variables, c.opts...)
+	es := graphql.OperationSchema(opCtx, c.es)
+	complexityCalcs := complexity.Calculate(ctx, es, op, opCtx.Variables, c.opts...)
 
 	limit := c.Func(ctx, opCtx)
 
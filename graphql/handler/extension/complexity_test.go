@@ -105,6 +105,19 @@ func TestFixedComplexity(t *testing.T) {
 		require.Equal(t, 4, stats.Complexity)
 	})
 
+	t.Run("above complexity limit with masked errors", func(t *testing.T) {
+		h.SetErrorPresenter((&graphql.ErrorMasking{}).Present)
+		defer h.SetErrorPresenter(graphql.DefaultErrorPresenter)
+
+		h.SetCalculatedComplexity(4)
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.JSONEq(
+			t,
+			`{"errors":[{"message":"operation has complexity 4, which exceeds the limit of 2","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}],"data":null}`,
+			resp.Body.String(),
+		)
+	})
+
 	t.Run("bypass __schema field", func(t *testing.T) {
 		h.SetCalculatedComplexity(4)
 		resp := doRequest(
@@ -0,0 +1,193 @@
+package extension
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// Logger logs every operation with log/slog once it completes: its name, type, duration,
+// complexity, error codes, client and variables. Sensitive variables are masked by Redactor.
+//
+// Operations with errors or slower than SlowThreshold are logged at the warn level, the others at
+// the info level.
+type Logger struct {
+	// Log is the logger the operations are logged to, slog.Default() when nil.
+	Log *slog.Logger
+
+	// Redactor masks the sensitive variables before they are logged, the variables passed to
+	// @sensitive arguments and input fields are always masked.
+	Redactor Redactor
+
+	// Sample reports whether to log an operation without errors and faster than SlowThreshold,
+	// every operation is logged when nil. See SampleRate.
+	Sample func(ctx context.Context, opCtx *graphql.OperationContext) bool
+
+	// SlowThreshold is the duration from which operations are logged at the warn level, even
+	// when they are not sampled. Zero disables it.
+	SlowThreshold time.Duration
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = &Logger{}
+
+const loggerExtension = "Logger"
+
+// SampleRate returns a Logger.Sample func logging the given fraction of operations, between 0 and 1.
+func SampleRate(rate float64) func(ctx context.Context, opCtx *graphql.OperationContext) bool {
+	return func(ctx context.Context, opCtx *graphql.OperationContext) bool {
+		return rand.Float64() < rate
+	}
+}
+
+func (l Logger) ExtensionName() string {
+	return loggerExtension
+}
+
+func (l *Logger) Validate(schema graphql.ExecutableSchema) error {
+	if l.Log == nil {
+		l.Log = slog.Default()
+	}
+	l.es = schema
+	return nil
+}
+
+// loggedSubscription holds the error codes of the responses of a subscription, it is logged once
+// it ends.
+type loggedSubscription struct {
+	codes []string
+}
+
+func (l *Logger) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	resp := next(ctx)
+	if !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	var codes []string
+	if resp != nil {
+		codes = errorCodes(resp.Errors)
+	}
+	if opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription {
+		sub, ok := opCtx.Stats.GetExtension(loggerExtension).(*loggedSubscription)
+		if !ok {
+			sub = &loggedSubscription{}
+			opCtx.Stats.SetExtension(loggerExtension, sub)
+		}
+		sub.codes = append(sub.codes, codes...)
+		if resp != nil {
+			return resp
+		}
+		codes = sub.codes
+	}
+
+	if resp != nil && resp.DataWriter != nil {
+		// the data of streamed responses is written after the interceptors return, with the
+		// errors raised while writing it
+		write := resp.DataWriter
+		resp.DataWriter = func(w io.Writer) gqlerror.List {
+			errs := write(w)
+			l.log(ctx, opCtx, append(codes, errorCodes(errs)...))
+			return errs
+		}
+		return resp
+	}
+
+	l.log(ctx, opCtx, codes)
+	return resp
+}
+
+func (l *Logger) log(ctx context.Context, opCtx *graphql.OperationContext, codes []string) {
+	var duration time.Duration
+	if !opCtx.Stats.OperationStart.IsZero() {
+		duration = graphql.Now().Sub(opCtx.Stats.OperationStart)
+	}
+	slow := l.SlowThreshold > 0 && duration >= l.SlowThreshold
+
+	level := slog.LevelInfo
+	if len(codes) > 0 || slow {
+		level = slog.LevelWarn
+	} else if l.Sample != nil && !l.Sample(ctx, opCtx) {
+		return
+	}
+	if !l.Log.Enabled(ctx, level) {
+		return
+	}
+
+	op := opCtx.Operation
+	name := opCtx.OperationName
+	if name == "" && op != nil {
+		name = op.Name
+	}
+	attrs := []slog.Attr{
+		slog.String("operation.name", name),
+		slog.Duration("duration", duration),
+	}
+	if op != nil {
+		attrs = append(attrs, slog.String("operation.type", string(op.Operation)))
+	}
+	if stats := GetComplexityStats(ctx); stats != nil {
+		attrs = append(attrs, slog.Int("complexity", stats.Complexity))
+	}
+	if len(codes) > 0 {
+		attrs = append(attrs, slog.Any("errors.codes", codes))
+	}
+	if client := clientAttrs(opCtx); len(client) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "client", Value: slog.GroupValue(client...)})
+	}
+	if len(opCtx.Variables) > 0 {
+		schema := graphql.OperationSchema(opCtx, l.es).Schema()
+		variables := l.Redactor.RedactVariables(schema, op, opCtx.Variables)
+		attrs = append(attrs, slog.Any("variables", variables))
+	}
+	if slow {
+		attrs = append(attrs, slog.Bool("slow", true))
+	}
+
+	l.Log.LogAttrs(ctx, level, "graphql operation", attrs...)
+}
+
+// errorCodes returns the codes of errs, errors without one are reported as INTERNAL_SERVER_ERROR.
+func errorCodes(errs gqlerror.List) []string {
+	codes := make([]string, 0, len(errs))
+	for _, err := range errs {
+		code, _ := err.Extensions["code"].(string)
+		if code == "" {
+			code = errcode.Internal
+		}
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// clientAttrs returns the name and version of the client of the operation, sent in the
+// apollographql-client-name and apollographql-client-version headers, and its user agent.
+func clientAttrs(opCtx *graphql.OperationContext) []slog.Attr {
+	var attrs []slog.Attr
+	for _, header := range []struct{ key, name string }{
+		{"name", "Apollographql-Client-Name"},
+		{"version", "Apollographql-Client-Version"},
+		{"userAgent", "User-Agent"},
+	} {
+		if value := opCtx.Headers.Get(header.name); value != "" {
+			attrs = append(attrs, slog.String(header.key, value))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,147 @@
+package extension_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := &extension.Logger{
+		Log:      slog.New(slog.NewJSONHandler(&buf, nil)),
+		Redactor: extension.Redactor{Patterns: []string{"id"}},
+	}
+	h := testserver.New()
+	h.Use(logger)
+	h.AddTransport(transport.POST{})
+
+	logs := func() []map[string]any {
+		var entries []map[string]any
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			require.Contains(t, entry, "duration")
+			delete(entry, "time")
+			delete(entry, "duration")
+			entries = append(entries, entry)
+		}
+		buf.Reset()
+		return entries
+	}
+	post := func(body string) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Apollographql-Client-Name", "web")
+		r.Header.Set("Apollographql-Client-Version", "1.2.0")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	t.Run("logs operations", func(t *testing.T) {
+		post(`{"query":"query Find($id: Int!) { find(id: $id) }","variables":{"id":1}}`)
+
+		require.Equal(t, []map[string]any{{
+			"level":          "INFO",
+			"msg":            "graphql operation",
+			"operation.name": "Find",
+			"operation.type": "query",
+			"client":         map[string]any{"name": "web", "version": "1.2.0"},
+			"variables":      map[string]any{"id": "[REDACTED]"},
+		}}, logs())
+	})
+
+	t.Run("logs error codes", func(t *testing.T) {
+		post(`{"query":"{ nope }"}`)
+
+		require.Equal(t, []map[string]any{{
+			"level":          "WARN",
+			"msg":            "graphql operation",
+			"operation.name": "",
+			"errors.codes":   []any{"GRAPHQL_VALIDATION_FAILED"},
+			"client":         map[string]any{"name": "web", "version": "1.2.0"},
+		}}, logs())
+	})
+
+	t.Run("samples operations", func(t *testing.T) {
+		logger.Sample = func(ctx context.Context, opCtx *graphql.OperationContext) bool {
+			return false
+		}
+		defer func() { logger.Sample = nil }()
+
+		post(`{"query":"{ name }"}`)
+		require.Empty(t, logs())
+
+		post(`{"query":"{ nope }"}`)
+		require.Len(t, logs(), 1)
+	})
+
+	t.Run("logs slow operations", func(t *testing.T) {
+		logger.Sample = extension.SampleRate(0)
+		logger.SlowThreshold = time.Nanosecond
+		defer func() {
+			logger.Sample = nil
+			logger.SlowThreshold = 0
+		}()
+
+		post(`{"query":"{ name }"}`)
+		entries := logs()
+		require.Len(t, entries, 1)
+		require.Equal(t, "WARN", entries[0]["level"])
+		require.Equal(t, true, entries[0]["slow"])
+	})
+	t.Run("logs the errors of streamed responses", func(t *testing.T) {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Input: `type Query { name: String! }`})
+		h := handler.New(&graphql.ExecutableSchemaMock{
+			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+				return func(ctx context.Context) *graphql.Response {
+					resp := &graphql.Response{}
+					resp.SetData(ctx, graphql.WriterFunc(func(w io.Writer) {
+						// like a scalar failing to marshal
+						graphql.AddErrorf(ctx, "name cannot be marshaled")
+						_, _ = io.WriteString(w, `{"name":null}`)
+					}))
+					return resp
+				}
+			},
+			SchemaFunc: func() *ast.Schema {
+				return schema
+			},
+		})
+		h.Use(logger)
+		h.AddTransport(transport.POST{StreamResponses: true})
+
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		require.JSONEq(t, `{"data":{"name":null},"errors":[{"message":"name cannot be marshaled"}]}`, w.Body.String())
+		require.Equal(t, []map[string]any{{
+			"level":          "WARN",
+			"msg":            "graphql operation",
+			"operation.name": "",
+			"operation.type": "query",
+			"errors.codes":   []any{"INTERNAL_SERVER_ERROR"},
+		}}, logs())
+	})
+}
@@ -0,0 +1,199 @@
+package extension
+
+import (
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// SensitiveDirective is the directive marking the arguments and input fields whose values the
+// Redactor masks, it must be declared in the schema:
+//
+//	directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+const SensitiveDirective = "sensitive"
+
+const defaultMask = "[REDACTED]"
+
+// Redactor masks the sensitive values of the variables of an operation, so extensions can log or
+// trace them. A value is sensitive when it is passed to an argument or an input field marked
+// @sensitive in the schema, or when its path matches one of Patterns.
+type Redactor struct {
+	// Patterns are the paths of the values to mask, their segments are the names of the
+	// variables and of the fields of their input objects, separated by dots. Lists add no
+	// segment. A "*" segment matches any name, a "**" segment any number of names, so
+	// "*.password" matches the password of every input variable and "**.token" every token.
+	Patterns []string
+
+	// Mask replaces the masked values, "[REDACTED]" when empty.
+	Mask string
+}
+
+// RedactVariables returns a copy of the variables of op where the sensitive values are masked.
+// The variables are not modified. schema and op can be nil, then only Patterns apply.
+func (r *Redactor) RedactVariables(
+	schema *ast.Schema,
+	op *ast.OperationDefinition,
+	variables map[string]any,
+) map[string]any {
+	if variables == nil {
+		return nil
+	}
+
+	sensitive := map[string]bool{}
+	if op != nil {
+		collectSensitiveVariables(op.SelectionSet, sensitive, map[string]bool{})
+	}
+
+	redacted := make(map[string]any, len(variables))
+	for name, value := range variables {
+		path := []string{name}
+		if sensitive[name] || r.matches(path) {
+			redacted[name] = r.mask()
+			continue
+		}
+		var typ *ast.Type
+		if op != nil {
+			if def := op.VariableDefinitions.ForName(name); def != nil {
+				typ = def.Type
+			}
+		}
+		redacted[name] = r.redactValue(schema, typ, value, path)
+	}
+	return redacted
+}
+
+func (r *Redactor) redactValue(schema *ast.Schema, typ *ast.Type, value any, path []string) any {
+	switch value := value.(type) {
+	case []any:
+		var elem *ast.Type
+		if typ != nil {
+			elem = typ.Elem
+		}
+		items := make([]any, len(value))
+		for i, item := range value {
+			items[i] = r.redactValue(schema, elem, item, path)
+		}
+		return items
+	case map[string]any:
+		var def *ast.Definition
+		if schema != nil && typ != nil {
+			def = schema.Types[typ.Name()]
+		}
+		fields := make(map[string]any, len(value))
+		for name, field := range value {
+			fieldPath := append(path[:len(path):len(path)], name)
+			var fieldType *ast.Type
+			if def != nil {
+				if fieldDef := def.Fields.ForName(name); fieldDef != nil {
+					if fieldDef.Directives.ForName(SensitiveDirective) != nil {
+						fields[name] = r.mask()
+						continue
+					}
+					fieldType = fieldDef.Type
+				}
+			}
+			if r.matches(fieldPath) {
+				fields[name] = r.mask()
+				continue
+			}
+			fields[name] = r.redactValue(schema, fieldType, field, fieldPath)
+		}
+		return fields
+	default:
+		return value
+	}
+}
+
+func (r *Redactor) mask() string {
+	if r.Mask == "" {
+		return defaultMask
+	}
+	return r.Mask
+}
+
+func (r *Redactor) matches(path []string) bool {
+	for _, pattern := range r.Patterns {
+		if matchPath(strings.Split(pattern, "."), path) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPath(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchPath(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 || (pattern[0] != "*" && pattern[0] != path[0]) {
+		return false
+	}
+	return matchPath(pattern[1:], path[1:])
+}
+
+// collectSensitiveVariables adds to sensitive the variables passed to the @sensitive arguments
+// and input fields of the fields of set.
+func collectSensitiveVariables(set ast.SelectionSet, sensitive, fragments map[string]bool) {
+	for _, selection := range set {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			for _, arg := range selection.Arguments {
+				var argDef *ast.ArgumentDefinition
+				if selection.Definition != nil {
+					argDef = selection.Definition.Arguments.ForName(arg.Name)
+				}
+				if argDef != nil && argDef.Directives.ForName(SensitiveDirective) != nil {
+					collectVariables(arg.Value, sensitive)
+				} else {
+					collectSensitiveInputVariables(arg.Value, sensitive)
+				}
+			}
+			collectSensitiveVariables(selection.SelectionSet, sensitive, fragments)
+		case *ast.InlineFragment:
+			collectSensitiveVariables(selection.SelectionSet, sensitive, fragments)
+		case *ast.FragmentSpread:
+			if fragments[selection.Name] || selection.Definition == nil {
+				continue
+			}
+			fragments[selection.Name] = true
+			collectSensitiveVariables(selection.Definition.SelectionSet, sensitive, fragments)
+		}
+	}
+}
+
+// collectSensitiveInputVariables adds to sensitive the variables passed to the @sensitive fields
+// of the input objects of value.
+func collectSensitiveInputVariables(value *ast.Value, sensitive map[string]bool) {
+	if value == nil {
+		return
+	}
+	for _, child := range value.Children {
+		if value.Kind == ast.ObjectValue && value.Definition != nil {
+			fieldDef := value.Definition.Fields.ForName(child.Name)
+			if fieldDef != nil && fieldDef.Directives.ForName(SensitiveDirective) != nil {
+				collectVariables(child.Value, sensitive)
+				continue
+			}
+		}
+		collectSensitiveInputVariables(child.Value, sensitive)
+	}
+}
+
+func collectVariables(value *ast.Value, variables map[string]bool) {
+	if value == nil {
+		return
+	}
+	if value.Kind == ast.Variable {
+		variables[value.Raw] = true
+	}
+	for _, child := range value.Children {
+		collectVariables(child.Value, variables)
+	}
+}
@@ -0,0 +1,104 @@
+package extension_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+func TestRedactor(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
+		type Query {
+			login(user: String!, password: String! @sensitive): String
+			register(input: UserInput!): String
+			registerAll(inputs: [UserInput!]!): String
+		}
+
+		input UserInput {
+			name: String!
+			password: String! @sensitive
+			profile: ProfileInput
+		}
+
+		input ProfileInput {
+			bio: String
+			token: String @sensitive
+		}
+	`})
+	doc := gqlparser.MustLoadQuery(schema, `
+		query Login($user: String!, $pw: String!, $inputs: [UserInput!]!, $input: UserInput!, $bio: String, $secret: String!) {
+			login(user: $user, password: $pw)
+			register(input: $input)
+			registerAll(inputs: $inputs)
+			...Register
+		}
+
+		fragment Register on Query {
+			... on Query {
+				other: register(input: {name: $user, password: $secret, profile: {bio: $bio}})
+			}
+		}
+	`)
+	op := doc.Operations[0]
+	variables := func() map[string]any {
+		return map[string]any{
+			"user":   "gopher",
+			"pw":     "hunter2",
+			"secret": "hunter3",
+			"bio":    "hi",
+			"input": map[string]any{
+				"name":     "gopher",
+				"password": "hunter4",
+				"profile":  map[string]any{"bio": "hi", "token": "abc"},
+			},
+			"inputs": []any{map[string]any{"name": "gopher", "password": "hunter5"}},
+		}
+	}
+
+	t.Run("masks the values marked @sensitive", func(t *testing.T) {
+		vars := variables()
+		r := &extension.Redactor{}
+
+		require.Equal(t, map[string]any{
+			"user":   "gopher",
+			"pw":     "[REDACTED]",
+			"secret": "[REDACTED]",
+			"bio":    "hi",
+			"input": map[string]any{
+				"name":     "gopher",
+				"password": "[REDACTED]",
+				"profile":  map[string]any{"bio": "hi", "token": "[REDACTED]"},
+			},
+			"inputs": []any{map[string]any{"name": "gopher", "password": "[REDACTED]"}},
+		}, r.RedactVariables(schema, op, vars))
+		require.Equal(t, variables(), vars)
+	})
+
+	t.Run("masks the values matching patterns", func(t *testing.T) {
+		r := &extension.Redactor{Patterns: []string{"user", "*.name", "**.bio"}, Mask: "***"}
+
+		require.Equal(t, map[string]any{
+			"user":   "***",
+			"pw":     "hunter2",
+			"secret": "hunter3",
+			"bio":    "***",
+			"input": map[string]any{
+				"name":     "***",
+				"password": "hunter4",
+				"profile":  map[string]any{"bio": "***", "token": "abc"},
+			},
+			"inputs": []any{map[string]any{"name": "***", "password": "hunter5"}},
+		}, r.RedactVariables(nil, nil, variables()))
+	})
+
+	t.Run("nil variables", func(t *testing.T) {
+		r := &extension.Redactor{}
+		require.Nil(t, r.RedactVariables(schema, op, nil))
+	})
+}
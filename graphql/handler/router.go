@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// SchemaVersionExtension is the key of the request extension selecting the schema version of an
+// operation, see Router.SelectVersionByExtension.
+const SchemaVersionExtension = "schemaVersion"
+
+const errUnknownSchemaVersion = "UNKNOWN_SCHEMA_VERSION"
+
+func init() {
+	errcode.RegisterErrorType(errUnknownSchemaVersion, errcode.KindProtocol)
+	errcode.RegisterPublicCode(errUnknownSchemaVersion)
+}
+
+// Router serves several versions of a schema from one handler, like v1 and v2 of an API during a
+// migration. Each version is a Server with its own query cache, error presenter and extensions,
+// while the transports and the shared extensions are configured once on the Router.
+//
+// The version of a request is selected, in order, by the schemaVersion request extension, a
+// header and the first segment of the path, as enabled with the SelectVersionBy methods. Requests
+// selecting no version are served by the default version.
+//
+//	router := handler.NewRouter()
+//	router.AddTransport(transport.POST{})
+//	router.Use(&extension.Logger{})
+//	router.AddVersion("v1", v1.NewExecutableSchema(v1Config)).SetQueryCache(lru.New[*ast.QueryDocument](1000))
+//	router.AddVersion("v2", v2.NewExecutableSchema(v2Config)).SetQueryCache(lru.New[*ast.QueryDocument](1000))
+//	router.SelectVersionByPathPrefix() // /v1/graphql and /v2/graphql
+//	router.SelectVersionByHeader("X-Schema-Version")
+type Router struct {
+	transports []graphql.Transport
+	extensions []graphql.HandlerExtension
+	versions   map[string]*Server
+	drainer    *transport.Drainer
+
+	defaultVersion string
+	pathPrefix     bool
+	header         string
+	extension      bool
+}
+
+// NewRouter returns a Router without versions. Like a Server, it is not ready for use until its
+// transports are added with Router.AddTransport.
+func NewRouter() *Router {
+	return &Router{
+		versions: map[string]*Server{},
+		drainer:  transport.NewDrainer(),
+	}
+}
+
+// AddTransport adds a transport shared by all the versions. The router picks the first supported
+// transport.
+func (r *Router) AddTransport(transport graphql.Transport) {
+	r.transports = append(r.transports, transport)
+}
+
+// Use adds an extension shared by all the versions, the ones already added and the ones added
+// later. It is validated against the schema of every version, so it must not keep the schema it is
+// validated with but read the schema of each operation with graphql.OperationSchema.
+// Shared extensions run before the extensions added to a version after them.
+func (r *Router) Use(extension graphql.HandlerExtension) {
+	r.extensions = append(r.extensions, extension)
+	for _, srv := range r.versions {
+		srv.Use(extension)
+	}
+}
+
+// AddVersion adds the version of the schema served for requests selecting version, and returns
+// its Server to configure the query cache, the error presenter and the extensions of the version.
+// The transports of the returned Server are not used by the Router. The first version added is
+// the default version.
+func (r *Router) AddVersion(version string, es graphql.ExecutableSchema) *Server {
+	if _, ok := r.versions[version]; ok {
+		panic(fmt.Sprintf("schema version %q is already added", version))
+	}
+	srv := New(es)
+	for _, extension := range r.extensions {
+		srv.Use(extension)
+	}
+	r.versions[version] = srv
+	if r.defaultVersion == "" {
+		r.defaultVersion = version
+	}
+	return srv
+}
+
+// SetDefaultVersion sets the version serving the requests selecting no version.
+func (r *Router) SetDefaultVersion(version string) {
+	if _, ok := r.versions[version]; !ok {
+		panic(fmt.Sprintf("schema version %q is not added", version))
+	}
+	r.defaultVersion = version
+}
+
+// SelectVersionByPathPrefix selects the version named by the first segment of the request path,
+// like v2 for /v2/graphql. Paths whose first segment is not a version select no version.
+func (r *Router) SelectVersionByPathPrefix() {
+	r.pathPrefix = true
+}
+
+// SelectVersionByHeader selects the version named by the header of the request. Requests naming
+// an unknown version fail.
+func (r *Router) SelectVersionByHeader(header string) {
+	r.header = header
+}
+
+// SelectVersionByExtension selects the version named by the schemaVersion extension of each
+// operation, which takes precedence over the header and the path. Operations naming an unknown
+// version fail.
+func (r *Router) SelectVersionByExtension() {
+	r.extension = true
+}
+
+// Drain prepares the router for shutdown, see Server.Drain.
+func (r *Router) Drain(ctx context.Context) error {
+	return r.drainer.Drain(ctx)
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if len(r.versions) == 0 {
+		panic("handler.Router has no schema version, add one with Router.AddVersion")
+	}
+	version, err := r.requestVersion(req)
+	defer func() {
+		if err := recover(); err != nil {
+			err := r.server(version).exec.PresentRecoveredError(req.Context(), err)
+			gqlErr, _ := err.(*gqlerror.Error)
+			resp := &graphql.Response{Errors: []*gqlerror.Error{gqlErr}}
+			b, _ := json.Marshal(resp)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write(b)
+		}
+	}()
+
+	if err != nil {
+		sendError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := transport.WithDrainer(graphql.StartOperationTrace(req.Context()), r.drainer)
+	req = req.WithContext(context.WithValue(ctx, schemaVersionCtx, version))
+
+	transport := r.getTransport(req)
+	if transport == nil {
+		sendErrorf(w, http.StatusBadRequest, "transport not supported")
+		return
+	}
+
+	transport.Do(w, req, routerExecutor{r})
+}
+
+func (r *Router) getTransport(req *http.Request) graphql.Transport {
+	for _, t := range r.transports {
+		if t.Supports(req) {
+			return t
+		}
+	}
+	return nil
+}
+
+// requestVersion returns the version selected by the header or the path of req, or the default
+// version.
+func (r *Router) requestVersion(req *http.Request) (string, *gqlerror.Error) {
+	if r.header != "" {
+		if version := req.Header.Get(r.header); version != "" {
+			return version, r.checkVersion(version)
+		}
+	}
+	if r.pathPrefix {
+		segment, _, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+		if _, ok := r.versions[segment]; ok {
+			return segment, nil
+		}
+	}
+	return r.defaultVersion, nil
+}
+
+// operationVersion returns the version of an operation, selected by its extensions or by the
+// request it was sent with.
+func (r *Router) operationVersion(
+	ctx context.Context,
+	extensions map[string]any,
+) (string, *gqlerror.Error) {
+	if r.extension {
+		if version, ok := extensions[SchemaVersionExtension].(string); ok && version != "" {
+			return version, r.checkVersion(version)
+		}
+	}
+	if version, ok := ctx.Value(schemaVersionCtx).(string); ok {
+		return version, nil
+	}
+	return r.defaultVersion, nil
+}
+
+func (r *Router) checkVersion(version string) *gqlerror.Error {
+	if _, ok := r.versions[version]; ok {
+		return nil
+	}
+	err := gqlerror.Errorf("unknown schema version %q", version)
+	errcode.Set(err, errUnknownSchemaVersion)
+	return err
+}
+
+// server returns the Server of version, or of the default version when version is unknown.
+func (r *Router) server(version string) *Server {
+	if srv, ok := r.versions[version]; ok {
+		return srv
+	}
+	return r.versions[r.defaultVersion]
+}
+
+type schemaVersionCtxKey struct{}
+
+var schemaVersionCtx = schemaVersionCtxKey{}
+
+// routerExecutor is the graphql.GraphExecutor the transports of a Router use, it dispatches each
+// operation to the executor of its version.
+type routerExecutor struct {
+	r *Router
+}
+
+var (
+	_ graphql.GraphExecutor        = routerExecutor{}
+	_ graphql.RawVariablesExecutor = routerExecutor{}
+)
+
+func (e routerExecutor) CreateOperationContext(
+	ctx context.Context,
+	params *graphql.RawParams,
+) (*graphql.OperationContext, gqlerror.List) {
+	version, err := e.r.operationVersion(ctx, params.Extensions)
+	if err != nil {
+		return &graphql.OperationContext{Extensions: params.Extensions}, gqlerror.List{err}
+	}
+	return e.r.server(version).exec.CreateOperationContext(ctx, params)
+}
+
+func (e routerExecutor) DispatchOperation(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) (graphql.ResponseHandler, context.Context) {
+	// the version was checked when the operation context was created
+	version, _ := e.r.operationVersion(ctx, opCtx.Extensions)
+	return e.r.server(version).exec.DispatchOperation(ctx, opCtx)
+}
+
+func (e routerExecutor) DispatchError(ctx context.Context, list gqlerror.List) *graphql.Response {
+	version, _ := ctx.Value(schemaVersionCtx).(string)
+	return e.r.server(version).exec.DispatchError(ctx, list)
+}
+
+// DecodesRawVariables reports whether the variables are left undecoded by the transports, which
+// is only possible when every version decodes them.
+func (e routerExecutor) DecodesRawVariables() bool {
+	for _, srv := range e.r.versions {
+		if !srv.exec.DecodesRawVariables() {
+			return false
+		}
+	}
+	return len(e.r.versions) > 0
+}
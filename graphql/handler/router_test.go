@@ -0,0 +1,137 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func versionSchema(version, query string) graphql.ExecutableSchema {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: query})
+	return &graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"version":"` + version + `"}`)})
+		},
+		SchemaFunc: func() *ast.Schema {
+			return schema
+		},
+	}
+}
+
+func TestRouter(t *testing.T) {
+	v1 := versionSchema("v1", `type Query { name: String! }`)
+	v2 := versionSchema("v2", `type Query { fullName: String! }`)
+
+	var shared, v2Only []graphql.ExecutableSchema
+	router := handler.NewRouter()
+	router.AddTransport(transport.GET{})
+	router.AddTransport(transport.POST{})
+	router.Use(handler.OperationFunc(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		shared = append(shared, graphql.GetOperationContext(ctx).ExecutableSchema)
+		return next(ctx)
+	}))
+	router.AddVersion("v1", v1)
+	router.AddVersion("v2", v2).Use(handler.OperationFunc(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		v2Only = append(v2Only, graphql.GetOperationContext(ctx).ExecutableSchema)
+		return next(ctx)
+	}))
+	router.SelectVersionByPathPrefix()
+	router.SelectVersionByHeader("X-Schema-Version")
+	router.SelectVersionByExtension()
+
+	t.Run("serves the default version", func(t *testing.T) {
+		resp := get(router, "/graphql?query={name}")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"version":"v1"}}`, resp.Body.String())
+	})
+
+	t.Run("selects the version by path prefix", func(t *testing.T) {
+		resp := get(router, "/v2/graphql?query={fullName}")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"version":"v2"}}`, resp.Body.String())
+	})
+
+	t.Run("validates against the schema of the version", func(t *testing.T) {
+		resp := get(router, "/v2/graphql?query={name}")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.Contains(t, resp.Body.String(), `Cannot query field \"name\" on type \"Query\".`)
+	})
+
+	t.Run("selects the version by header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/graphql?query={fullName}", http.NoBody)
+		r.Header.Set("X-Schema-Version", "v2")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":{"version":"v2"}}`, w.Body.String())
+	})
+
+	t.Run("rejects unknown versions in headers", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/graphql?query={name}", http.NoBody)
+		r.Header.Set("X-Schema-Version", "v9")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(
+			t,
+			`{"errors":[{"message":"unknown schema version \"v9\"","extensions":{"code":"UNKNOWN_SCHEMA_VERSION"}}],"data":null}`,
+			w.Body.String(),
+		)
+	})
+
+	t.Run("selects the version by extension", func(t *testing.T) {
+		resp := postJSON(router, "/v1/graphql", `{"query":"{ fullName }","extensions":{"schemaVersion":"v2"}}`)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"version":"v2"}}`, resp.Body.String())
+	})
+
+	t.Run("rejects unknown versions in extensions", func(t *testing.T) {
+		resp := postJSON(router, "/graphql", `{"query":"{ name }","extensions":{"schemaVersion":"v9"}}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(
+			t,
+			`{"errors":[{"message":"unknown schema version \"v9\"","extensions":{"code":"UNKNOWN_SCHEMA_VERSION"}}],"data":null}`,
+			resp.Body.String(),
+		)
+	})
+
+	t.Run("runs shared and version extensions", func(t *testing.T) {
+		shared, v2Only = nil, nil
+		get(router, "/v1/graphql?query={name}")
+		get(router, "/v2/graphql?query={fullName}")
+		assert.Equal(t, []graphql.ExecutableSchema{v1, v2}, shared)
+		assert.Equal(t, []graphql.ExecutableSchema{v2}, v2Only)
+	})
+
+	t.Run("changes the default version", func(t *testing.T) {
+		router := handler.NewRouter()
+		router.AddTransport(transport.GET{})
+		router.AddVersion("v1", v1)
+		router.AddVersion("v2", v2)
+		router.SetDefaultVersion("v2")
+
+		resp := get(router, "/graphql?query={fullName}")
+		assert.JSONEq(t, `{"data":{"version":"v2"}}`, resp.Body.String())
+		assert.Panics(t, func() { router.SetDefaultVersion("v3") })
+		assert.Panics(t, func() { router.AddVersion("v1", v1) })
+	})
+}
+
+func postJSON(handler http.Handler, target, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+	return w
+}
@@ -23,6 +23,7 @@ type (
 	Server struct {
 		transports []graphql.Transport
 		exec       *executor.Executor
+		drainer    *transport.Drainer
 	}
 )
 
@@ -32,7 +33,8 @@ type (
 // example.
 func New(es graphql.ExecutableSchema) *Server {
 	return &Server{
-		exec: executor.New(es),
+		exec:    executor.New(es),
+		drainer: transport.NewDrainer(),
 	}
 }
 
@@ -99,6 +101,10 @@ func (s *Server) SetDisableSuggestion(value bool) {
 	s.exec.SetDisableSuggestion(value)
 }
 
+func (s *Server) SetOperationTimeout(timeout time.Duration) {
+	s.exec.SetOperationTimeout(timeout)
+}
+
 // Use adds the given extension middleware to the server. Extensions are run in
 // order from first to last added.
 func (s *Server) Use(extension graphql.HandlerExtension) {
@@ -155,7 +161,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
-	r = r.WithContext(graphql.StartOperationTrace(r.Context()))
+	r = r.WithContext(transport.WithDrainer(graphql.StartOperationTrace(r.Context()), s.drainer))
 
 	transport := s.getTransport(r)
 	if transport == nil {
@@ -166,6 +172,19 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	transport.Do(w, r, s.exec)
 }
 
+// Drain prepares the server for shutdown. New websocket and SSE operations are refused, active
+// subscriptions are completed and websocket clients are closed with
+// [transport.GoingAwayCloseReason] so they reconnect to another instance. Drain then waits for
+// operations already in flight to finish, or for ctx to be done.
+//
+// [http.Server.Shutdown] does not wait for hijacked websocket connections, so call Drain first:
+//
+//	_ = gqlServer.Drain(ctx)
+//	_ = httpServer.Shutdown(ctx)
+func (s *Server) Drain(ctx context.Context) error {
+	return s.drainer.Drain(ctx)
+}
+
 func sendError(w http.ResponseWriter, code int, errors ...*gqlerror.Error) {
 	w.WriteHeader(code)
 	b, err := json.Marshal(&graphql.Response{Errors: errors})
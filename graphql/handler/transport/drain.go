@@ -0,0 +1,110 @@
+package transport
+
+import (
+	"context"
+	"sync"
+)
+
+// Drainer tracks the operations running on long lived transports (websocket and SSE) so a server
+// can stop them gracefully before shutting down. Once Drain is called new operations are refused,
+// subscriptions are completed and queries and mutations already in flight are allowed to finish.
+//
+// A nil *Drainer is valid and never drains, which is what transports see when they are used
+// without a handler.Server.
+type Drainer struct {
+	mu       sync.Mutex
+	draining chan struct{}
+	stopped  bool
+	ops      sync.WaitGroup
+}
+
+// NewDrainer returns a Drainer that is accepting operations.
+func NewDrainer() *Drainer {
+	return &Drainer{
+		draining: make(chan struct{}),
+	}
+}
+
+// Draining returns a channel that is closed once Drain has been called.
+func (d *Drainer) Draining() <-chan struct{} {
+	if d == nil {
+		return nil
+	}
+	return d.draining
+}
+
+// IsDraining reports whether Drain has been called.
+func (d *Drainer) IsDraining() bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.stopped
+}
+
+// Acquire registers a new operation. It returns false if the drainer is draining, in which case
+// the operation must be refused. Every successful Acquire must be paired with a call to Release.
+func (d *Drainer) Acquire() bool {
+	if d == nil {
+		return true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped {
+		return false
+	}
+	d.ops.Add(1)
+	return true
+}
+
+// Release marks an operation registered with Acquire as finished.
+func (d *Drainer) Release() {
+	if d == nil {
+		return
+	}
+	d.ops.Done()
+}
+
+// Drain stops new operations from being accepted and waits until all acquired operations have
+// been released, or ctx is done. It is safe to call more than once.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.stopped {
+		d.stopped = true
+		close(d.draining)
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.ops.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// A private key for context that only this package can access. This is important
+// to prevent collisions between different context uses
+var drainerCtxKey = &drainerContextKey{"drainer"}
+
+type drainerContextKey struct {
+	name string
+}
+
+// WithDrainer makes d available to the transports handling requests with the returned context.
+func WithDrainer(ctx context.Context, d *Drainer) context.Context {
+	return context.WithValue(ctx, drainerCtxKey, d)
+}
+
+// GetDrainer returns the Drainer set with WithDrainer, or nil.
+func GetDrainer(ctx context.Context) *Drainer {
+	d, _ := ctx.Value(drainerCtxKey).(*Drainer)
+	return d
+}
@@ -2,10 +2,11 @@ package transport
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"mime"
 	"net/http"
-	"os"
 
 	"github.com/99designs/gqlgen/graphql"
 )
@@ -25,6 +26,24 @@ type MultipartForm struct {
 	// Map of all headers that are added to graphql response. If not
 	// set, only one header: Content-Type: application/json will be set.
 	ResponseHeaders map[string][]string
+
+	// MaxFileSize limits the size of every single file in bytes. Zero means no limit other than
+	// MaxUploadSize.
+	MaxFileSize int64
+
+	// AllowedContentTypes restricts the content types files may be sent with, entries can be
+	// media types like "application/pdf" or wildcards like "image/*". Empty allows all.
+	AllowedContentTypes []string
+
+	// Store receives the files as they are read from the request, replacing the default of
+	// buffering them in memory up to MaxMemory and in temporary files beyond that.
+	Store UploadStore
+
+	// StreamUploads starts executing the operation as soon as the operations and map fields are
+	// read. Each Upload's File then reads directly from the request body and implements
+	// graphql.UploadStream; files must be read in the order they are listed in the map field.
+	// Store and MaxMemory are not used in this mode.
+	StreamUploads bool
 }
 
 var _ graphql.Transport = MultipartForm{}
@@ -97,13 +116,37 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 		return
 	}
 
-	uploadsMap := map[string][]string{}
-	if err = json.NewDecoder(part).Decode(&uploadsMap); err != nil {
+	uploadKeys, uploadsMap, err := decodeUploadsMap(part)
+	if err != nil {
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		writeJsonError(w, "map form field could not be decoded")
 		return
 	}
 
+	if f.StreamUploads {
+		f.stream(w, r, exec, mr, &params, uploadKeys, uploadsMap, start)
+		return
+	}
+
+	var store UploadStore
+	switch {
+	case f.Store != nil:
+		store = f.Store
+	case r.ContentLength < f.maxMemory():
+		store = MemoryUploadStore{}
+	default:
+		temp := &tempUploadStore{}
+		defer temp.cleanup()
+		store = temp
+	}
+
+	var closers []io.Closer
+	defer func() {
+		for _, c := range closers {
+			_ = c.Close()
+		}
+	}()
+
 	for {
 		part, err = mr.NextPart()
 		if err == io.EOF {
@@ -126,75 +169,54 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 		}
 		delete(uploadsMap, key)
 
-		var upload graphql.Upload
-		if r.ContentLength < f.maxMemory() {
-			fileBytes, err := io.ReadAll(part)
+		if !contentTypeAllowed(f.AllowedContentTypes, contentType) {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			writeJsonErrorf(w, "content type %s is not allowed for key %s", contentType, key)
+			return
+		}
+
+		var content io.Reader = part
+		if f.MaxFileSize > 0 {
+			content = &maxSizeReader{r: part, n: f.MaxFileSize}
+		}
+
+		stored, err := store.Save(r.Context(), UploadFile{
+			Key:         key,
+			Filename:    filename,
+			ContentType: contentType,
+		}, content)
+		if errors.Is(err, ErrFileTooLarge) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			writeJsonErrorf(w, "file for key %s exceeds the maximum size of %d bytes", key, f.MaxFileSize)
+			return
+		} else if err != nil {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			writeJsonErrorf(w, "failed to store file for key %s", key)
+			return
+		}
+
+		for _, path := range paths {
+			file, err := stored.Open(r.Context())
 			if err != nil {
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				writeJsonErrorf(w, "failed to read file for key %s", key)
+				writeJsonErrorf(w, "failed to open file for key %s", key)
 				return
 			}
-			for _, path := range paths {
-				upload = graphql.Upload{
-					File:        &bytesReader{s: &fileBytes, i: 0},
-					Size:        int64(len(fileBytes)),
-					Filename:    filename,
-					ContentType: contentType,
-				}
-
-				if err := params.AddUpload(upload, key, path); err != nil {
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					writeJsonGraphqlError(w, err)
-					return
-				}
+			if c, ok := file.(io.Closer); ok {
+				closers = append(closers, c)
 			}
-		} else {
-			tmpFile, err := os.CreateTemp(os.TempDir(), "gqlgen-")
-			if err != nil {
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				writeJsonErrorf(w, "failed to create temp file for key %s", key)
-				return
+			upload := graphql.Upload{
+				File:        file,
+				Size:        stored.Size(),
+				Filename:    filename,
+				ContentType: contentType,
 			}
-			tmpName := tmpFile.Name()
-			defer func() {
-				_ = os.Remove(tmpName)
-			}()
-			fileSize, err := io.Copy(tmpFile, part)
-			if err != nil {
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				if err := tmpFile.Close(); err != nil {
-					writeJsonErrorf(w, "failed to copy to temp file and close temp file for key %s", key)
-					return
-				}
-				writeJsonErrorf(w, "failed to copy to temp file for key %s", key)
-				return
-			}
-			if err := tmpFile.Close(); err != nil {
+
+			if err := params.AddUpload(upload, key, path); err != nil {
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				writeJsonErrorf(w, "failed to close temp file for key %s", key)
+				writeJsonGraphqlError(w, err)
 				return
 			}
-			for _, path := range paths {
-				pathTmpFile, err := os.Open(tmpName)
-				if err != nil {
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					writeJsonErrorf(w, "failed to open temp file for key %s", key)
-					return
-				}
-				defer pathTmpFile.Close()
-				upload = graphql.Upload{
-					File:        pathTmpFile,
-					Size:        fileSize,
-					Filename:    filename,
-					ContentType: contentType,
-				}
-
-				if err := params.AddUpload(upload, key, path); err != nil {
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					writeJsonGraphqlError(w, err)
-					return
-				}
-			}
 		}
 	}
 
@@ -221,3 +243,36 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 	responses, ctx := exec.DispatchOperation(r.Context(), rc)
 	writeJson(w, responses(ctx))
 }
+
+// decodeUploadsMap decodes the map field, keeping the order of its keys.
+func decodeUploadsMap(r io.Reader) ([]string, map[string][]string, error) {
+	dec := json.NewDecoder(r)
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil, nil, errors.New("map must be an object")
+	}
+
+	var keys []string
+	uploadsMap := map[string][]string{}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, ok := t.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected token %v", t)
+		}
+		var paths []string
+		if err := dec.Decode(&paths); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := uploadsMap[key]; !ok {
+			keys = append(keys, key)
+		}
+		uploadsMap[key] = paths
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	return keys, uploadsMap, nil
+}
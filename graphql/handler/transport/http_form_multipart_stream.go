@@ -0,0 +1,194 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// stream executes the operation before the files have been read, handing the resolvers uploads
+// that read straight from the multipart body.
+func (f MultipartForm) stream(
+	w http.ResponseWriter,
+	r *http.Request,
+	exec graphql.GraphExecutor,
+	mr *multipart.Reader,
+	params *graphql.RawParams,
+	keys []string,
+	uploadsMap map[string][]string,
+	start time.Time,
+) {
+	s := &uploadStream{
+		mr:          mr,
+		passed:      map[string]bool{},
+		allowed:     f.AllowedContentTypes,
+		maxFileSize: f.MaxFileSize,
+	}
+
+	for _, key := range keys {
+		paths := uploadsMap[key]
+		if len(paths) == 0 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			writeJsonErrorf(w, "invalid empty operations paths list for key %s", key)
+			return
+		}
+
+		// all paths of a key share the stream, there is only one copy of the file.
+		file := &streamedFile{stream: s, key: key}
+		for _, path := range paths {
+			upload := graphql.Upload{File: file, Size: -1}
+			if err := params.AddUpload(upload, key, path); err != nil {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				writeJsonGraphqlError(w, err)
+				return
+			}
+		}
+	}
+
+	params.Headers = r.Header
+
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	rc, gerr := exec.CreateOperationContext(r.Context(), params)
+	if gerr != nil {
+		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), gerr)
+		w.WriteHeader(statusFor(gerr))
+		writeJson(w, resp)
+		return
+	}
+	responses, ctx := exec.DispatchOperation(r.Context(), rc)
+	resp := responses(ctx)
+
+	// consume whatever the resolvers did not read before responding, so the client is not stuck
+	// sending the rest of its body.
+	_, _ = io.Copy(io.Discard, r.Body)
+	writeJson(w, resp)
+}
+
+type uploadStream struct {
+	mu          sync.Mutex
+	mr          *multipart.Reader
+	current     *streamedFile
+	passed      map[string]bool
+	err         error
+	allowed     []string
+	maxFileSize int64
+}
+
+type streamedFile struct {
+	stream *uploadStream
+	key    string
+
+	content     io.Reader
+	offset      int64
+	filename    string
+	contentType string
+	err         error
+}
+
+var _ graphql.UploadStream = &streamedFile{}
+
+func (f *streamedFile) Open() error {
+	f.stream.mu.Lock()
+	defer f.stream.mu.Unlock()
+	return f.open()
+}
+
+func (f *streamedFile) open() error {
+	if f.content != nil || f.err != nil {
+		return f.err
+	}
+
+	s := f.stream
+	if s.passed[f.key] {
+		f.err = fmt.Errorf(
+			"file for key %s was skipped, files must be read in the order they are sent",
+			f.key,
+		)
+		return f.err
+	}
+
+	for {
+		if s.err != nil {
+			f.err = s.err
+			return f.err
+		}
+
+		part, err := s.mr.NextPart()
+		if err == io.EOF {
+			f.err = fmt.Errorf("failed to get key %s from form", f.key)
+			return f.err
+		} else if err != nil {
+			s.err = errors.New("failed to parse part")
+			continue
+		}
+
+		key := part.FormName()
+		s.passed[key] = true
+		if key != f.key {
+			continue
+		}
+
+		f.filename = part.FileName()
+		f.contentType = part.Header.Get("Content-Type")
+		if !contentTypeAllowed(s.allowed, f.contentType) {
+			f.err = fmt.Errorf("content type %s is not allowed for key %s", f.contentType, f.key)
+			return f.err
+		}
+
+		f.content = part
+		if s.maxFileSize > 0 {
+			f.content = &maxSizeReader{r: part, n: s.maxFileSize}
+		}
+		s.current = f
+		return nil
+	}
+}
+
+func (f *streamedFile) Read(p []byte) (int, error) {
+	f.stream.mu.Lock()
+	defer f.stream.mu.Unlock()
+
+	if err := f.open(); err != nil {
+		return 0, err
+	}
+	if f.stream.current != f {
+		return 0, fmt.Errorf("file for key %s is no longer readable, a later file has been read", f.key)
+	}
+
+	n, err := f.content.Read(p)
+	f.offset += int64(n)
+	return n, err
+}
+
+// Seek only supports reporting the current offset, streams can not be rewound.
+func (f *streamedFile) Seek(offset int64, whence int) (int64, error) {
+	f.stream.mu.Lock()
+	defer f.stream.mu.Unlock()
+
+	if offset == 0 && whence == io.SeekCurrent {
+		return f.offset, nil
+	}
+	return f.offset, errors.New("streamed uploads can not seek")
+}
+
+func (f *streamedFile) Filename() string {
+	f.stream.mu.Lock()
+	defer f.stream.mu.Unlock()
+	return f.filename
+}
+
+func (f *streamedFile) ContentType() string {
+	f.stream.mu.Lock()
+	defer f.stream.mu.Unlock()
+	return f.contentType
+}
@@ -9,6 +9,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -314,6 +318,293 @@ func TestFileUpload(t *testing.T) {
 	})
 }
 
+func TestFileUploadStore(t *testing.T) {
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema {
+			return gqlparser.MustLoadSchema(&ast.Source{Input: `
+				type Mutation {
+					singleUpload(file: Upload!): String!
+					multipleUpload(files: [Upload!]!): String!
+				}
+				scalar Upload
+			`})
+		},
+	}
+
+	// readUploads returns the content of all uploads in the variables, in the order of the
+	// files variable.
+	readUploads := func(t *testing.T, ctx context.Context) []string {
+		vars := graphql.GetOperationContext(ctx).Variables
+		var uploads []graphql.Upload
+		if file, ok := vars["file"]; ok {
+			uploads = append(uploads, file.(graphql.Upload))
+		}
+		if files, ok := vars["files"]; ok {
+			for _, file := range files.([]any) {
+				uploads = append(uploads, file.(graphql.Upload))
+			}
+		}
+
+		var contents []string
+		for _, upload := range uploads {
+			b, err := io.ReadAll(upload.File)
+			if err != nil {
+				contents = append(contents, "error: "+err.Error())
+				continue
+			}
+			name := upload.Filename
+			if stream, ok := upload.File.(graphql.UploadStream); ok {
+				name = stream.Filename()
+			}
+			contents = append(contents, name+":"+string(b))
+		}
+		return contents
+	}
+
+	singleOperations := `{ "query": "mutation ($file: Upload!) { singleUpload(file: $file) }", "variables": { "file": null } }`
+	singleMap := `{ "0": ["variables.file"] }`
+	multipleOperations := `{ "query": "mutation($files: [Upload!]!) { multipleUpload(files: $files) }", "variables": { "files": [null, null] } }`
+	multipleMap := `{ "0": ["variables.files.0"], "1": ["variables.files.1"] }`
+	files := []file{
+		{mapKey: "0", name: "a.txt", content: "test1", contentType: "text/plain"},
+		{mapKey: "1", name: "b.png", content: "test2", contentType: "image/png"},
+	}
+
+	t.Run("memory store", func(t *testing.T) {
+		var got []string
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			got = readUploads(t, ctx)
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUpload":"ok"}`)})
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{Store: transport.MemoryUploadStore{}})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, multipleOperations, multipleMap, files))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, []string{"a.txt:test1", "b.png:test2"}, got)
+	})
+
+	t.Run("disk store keeps files", func(t *testing.T) {
+		dir := t.TempDir()
+		var names []string
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			upload := graphql.GetOperationContext(ctx).Variables["file"].(graphql.Upload)
+			names = append(names, upload.File.(*os.File).Name())
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"singleUpload":"ok"}`)})
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{Store: transport.DiskUploadStore{Dir: dir}})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, singleOperations, singleMap, files[:1]))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		require.Len(t, names, 1)
+		require.Equal(t, dir, filepath.Dir(names[0]))
+		b, err := os.ReadFile(names[0])
+		require.NoError(t, err)
+		require.Equal(t, "test1", string(b))
+	})
+
+	t.Run("object store", func(t *testing.T) {
+		store := &objectStore{objects: map[string]object{}}
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			readUploads(t, ctx)
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUpload":"ok"}`)})
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{Store: store})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, multipleOperations, multipleMap, files))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, map[string]object{
+			"0/a.txt": {contentType: "text/plain", content: "test1"},
+			"1/b.png": {contentType: "image/png", content: "test2"},
+		}, store.objects)
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		store := &objectStore{objects: map[string]object{}}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{Store: store, MaxFileSize: 4})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, singleOperations, singleMap, files[:1]))
+		require.Equal(t, http.StatusRequestEntityTooLarge, resp.Code, resp.Body.String())
+		require.JSONEq(
+			t,
+			`{"errors":[{"message":"file for key 0 exceeds the maximum size of 4 bytes"}],"data":null}`,
+			resp.Body.String(),
+		)
+		require.Empty(t, store.objects)
+
+		h = handler.New(es)
+		h.AddTransport(transport.MultipartForm{MaxFileSize: 5})
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"singleUpload":"ok"}`)})
+		}
+		resp = httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, singleOperations, singleMap, files[:1]))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	})
+
+	t.Run("content type not allowed", func(t *testing.T) {
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{AllowedContentTypes: []string{"image/*"}})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, multipleOperations, multipleMap, files))
+		require.Equal(t, http.StatusUnsupportedMediaType, resp.Code, resp.Body.String())
+		require.JSONEq(
+			t,
+			`{"errors":[{"message":"content type text/plain is not allowed for key 0"}],"data":null}`,
+			resp.Body.String(),
+		)
+	})
+}
+
+func TestFileUploadStreaming(t *testing.T) {
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema {
+			return gqlparser.MustLoadSchema(&ast.Source{Input: `
+				type Mutation {
+					multipleUpload(files: [Upload!]!): String!
+				}
+				scalar Upload
+			`})
+		},
+	}
+	operations := `{ "query": "mutation($files: [Upload!]!) { multipleUpload(files: $files) }", "variables": { "files": [null, null] } }`
+	mapData := `{ "1": ["variables.files.1"], "0": ["variables.files.0"] }`
+	files := []file{
+		{mapKey: "1", name: "b.txt", content: "test2", contentType: "text/plain"},
+		{mapKey: "0", name: "a.txt", content: "test1", contentType: "text/plain"},
+	}
+
+	uploadsOf := func(ctx context.Context) []graphql.UploadStream {
+		var streams []graphql.UploadStream
+		for _, file := range graphql.GetOperationContext(ctx).Variables["files"].([]any) {
+			upload := file.(graphql.Upload)
+			require.Equal(t, int64(-1), upload.Size)
+			streams = append(streams, upload.File.(graphql.UploadStream))
+		}
+		return streams
+	}
+
+	t.Run("files are read from the body in map order", func(t *testing.T) {
+		var got []string
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			streams := uploadsOf(ctx)
+			for _, stream := range []graphql.UploadStream{streams[1], streams[0]} {
+				require.NoError(t, stream.Open())
+				b, err := io.ReadAll(stream)
+				require.NoError(t, err)
+				got = append(got, stream.Filename()+":"+stream.ContentType()+":"+string(b))
+			}
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUpload":"ok"}`)})
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{StreamUploads: true})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, operations, mapData, files))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, []string{"b.txt:text/plain:test2", "a.txt:text/plain:test1"}, got)
+	})
+
+	t.Run("skipped files can not be read", func(t *testing.T) {
+		var errs []string
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			streams := uploadsOf(ctx)
+			_, err := io.ReadAll(streams[0])
+			require.NoError(t, err)
+			_, err = io.ReadAll(streams[1])
+			errs = append(errs, err.Error())
+			_, err = streams[0].Seek(0, io.SeekStart)
+			errs = append(errs, err.Error())
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUpload":"ok"}`)})
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{StreamUploads: true})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, operations, mapData, files))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, []string{
+			"file for key 1 was skipped, files must be read in the order they are sent",
+			"streamed uploads can not seek",
+		}, errs)
+	})
+
+	t.Run("policies apply while reading", func(t *testing.T) {
+		var errs []string
+		es.ExecFunc = func(ctx context.Context) graphql.ResponseHandler {
+			for _, stream := range uploadsOf(ctx) {
+				_, err := io.ReadAll(stream)
+				errs = append(errs, err.Error())
+			}
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"multipleUpload":"ok"}`)})
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.MultipartForm{StreamUploads: true, MaxFileSize: 2})
+
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, createUploadRequest(t, operations, mapData, files))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, []string{
+			transport.ErrFileTooLarge.Error(),
+			"file for key 1 was skipped, files must be read in the order they are sent",
+		}, errs)
+	})
+}
+
+// objectStore stands in for an S3 compatible object storage, files are put under their key and
+// file name.
+type objectStore struct {
+	mu      sync.Mutex
+	objects map[string]object
+}
+
+type object struct {
+	contentType string
+	content     string
+}
+
+func (s *objectStore) Save(
+	ctx context.Context,
+	file transport.UploadFile,
+	r io.Reader,
+) (transport.StoredUpload, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	key := file.Key + "/" + file.Filename
+	s.mu.Lock()
+	s.objects[key] = object{contentType: file.ContentType, content: string(b)}
+	s.mu.Unlock()
+	return storedObject{store: s, key: key}, nil
+}
+
+type storedObject struct {
+	store *objectStore
+	key   string
+}
+
+func (o storedObject) Size() int64 {
+	o.store.mu.Lock()
+	defer o.store.mu.Unlock()
+	return int64(len(o.store.objects[o.key].content))
+}
+
+func (o storedObject) Open(ctx context.Context) (io.ReadSeeker, error) {
+	o.store.mu.Lock()
+	defer o.store.mu.Unlock()
+	return strings.NewReader(o.store.objects[o.key].content), nil
+}
+
 type file struct {
 	mapKey      string
 	name        string
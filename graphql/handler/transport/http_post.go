@@ -2,6 +2,7 @@ package transport
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime"
@@ -24,6 +25,13 @@ type POST struct {
 	// as the response content type
 	// when the Accept header is empty or 'application/*' or '*/*'.
 	UseGrapQLResponseJsonByDefault bool
+
+	// StreamResponses writes the data of responses straight to the http.ResponseWriter as it is
+	// marshaled, rather than buffering it in graphql.Response.Data first. Errors are then written
+	// after the data. The data is written after every graphql.ResponseInterceptor has returned, so
+	// the errors raised while writing it are not in the Errors they see. Interceptors needing them
+	// wrap graphql.Response.DataWriter, like extension.Logger does.
+	StreamResponses bool
 }
 
 var _ graphql.Transport = POST{}
@@ -80,6 +88,7 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 		params.OperationName = ""
 		params.Query = ""
 		params.Variables = nil
+		params.RawVariables = nil
 
 		pool.Put(params)
 	}()
@@ -100,7 +109,7 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 	}
 
 	bodyReader := bytes.NewReader(bodyBytes)
-	if err := jsonDecode(bodyReader, &params); err != nil {
+	if err := decodeParams(bodyReader, params, exec); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		gqlErr := gqlerror.Errorf(
 			"json request body could not be decoded: %+v body:%s",
@@ -124,7 +133,29 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 		return
 	}
 
+	if h.StreamResponses {
+		ctx = graphql.WithStreamedResponse(ctx)
+	}
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
 	writeJson(w, responses(ctx))
 }
+
+// decodeParams decodes the request body into params, leaving the variables undecoded when exec
+// decodes them once the operation is known.
+func decodeParams(r io.Reader, params *graphql.RawParams, exec graphql.GraphExecutor) error {
+	if e, ok := exec.(graphql.RawVariablesExecutor); !ok || !e.DecodesRawVariables() {
+		return jsonDecode(r, &params)
+	}
+	body := struct {
+		*graphql.RawParams
+		Variables json.RawMessage `json:"variables"`
+	}{RawParams: params}
+	if err := jsonDecode(r, &body); err != nil {
+		return err
+	}
+	if len(body.Variables) > 0 {
+		params.RawVariables = body.Variables
+	}
+	return nil
+}
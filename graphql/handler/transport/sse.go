@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -51,6 +52,13 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		return
 	}
 
+	drainer := GetDrainer(ctx)
+	if !drainer.Acquire() {
+		SendErrorf(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+	defer drainer.Release()
+
 	c := &sseConnection{
 		ctx: ctx,
 		f:   flusher,
@@ -113,6 +121,21 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		resp := exec.DispatchError(ctx, opErr)
 		writeJsonWithSSE(w, resp)
 	} else {
+		if rc.Operation.Operation == ast.Subscription {
+			// subscriptions are completed when the server drains, the client is free to
+			// reconnect to another instance.
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			defer cancel()
+			go func() {
+				select {
+				case <-drainer.Draining():
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+		}
+
 		responses, ctx := exec.DispatchOperation(ctx, rc)
 		for {
 			response := responses(ctx)
@@ -0,0 +1,440 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SSEStreamTokenHeader carries the stream token reserved with a PUT request in the single
+// connection mode of the graphql-sse protocol.
+const SSEStreamTokenHeader = "X-GraphQL-Event-Stream-Token"
+
+// DefaultSSETokenTimeout is how long a reserved stream token stays valid while no event stream is
+// connected to it.
+const DefaultSSETokenTimeout = 30 * time.Second
+
+// SSESingleConnection implements the single connection mode of the graphql-sse protocol, see
+// https://github.com/enisdenjo/graphql-sse/blob/master/PROTOCOL.md. All operations of a client
+// share one event stream, which keeps browsers on HTTP/1.1 below their limit of 6 connections per
+// origin. Use SSE for the distinct connections mode.
+//
+//   - PUT reserves a stream and responds with its token.
+//   - GET with the token opens the event stream.
+//   - POST with the token starts an operation, identified by extensions.operationId.
+//   - DELETE with the token and an operationId query parameter stops an operation.
+//
+// The transport keeps the reserved streams between requests, so it must be added as a pointer:
+//
+//	srv.AddTransport(&transport.SSESingleConnection{})
+type SSESingleConnection struct {
+	KeepAlivePingInterval time.Duration
+	// TokenTimeout is how long a reserved token stays valid before the client opens the event
+	// stream. Defaults to DefaultSSETokenTimeout. Once the stream is closed the token is gone.
+	TokenTimeout time.Duration
+
+	mu      sync.Mutex
+	streams map[string]*sseStream
+}
+
+type (
+	sseStream struct {
+		token  string
+		expiry *time.Timer
+
+		mu        sync.Mutex
+		connected bool
+		closed    bool
+		draining  bool
+		w         io.Writer
+		f         http.Flusher
+		pending   []string
+		ops       map[string]*sseOperation
+		opDone    chan struct{}
+		done      chan struct{}
+	}
+
+	sseOperation struct {
+		cancel       context.CancelFunc
+		subscription bool
+	}
+)
+
+var _ graphql.Transport = &SSESingleConnection{}
+
+func (t *SSESingleConnection) Supports(r *http.Request) bool {
+	if r.Header.Get("Upgrade") != "" {
+		return false
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		return isStreamReservation(r)
+	case http.MethodGet:
+		return strings.Contains(r.Header.Get("Accept"), "text/event-stream") && streamToken(r) != ""
+	case http.MethodPost, http.MethodDelete:
+		return streamToken(r) != ""
+	default:
+		return false
+	}
+}
+
+func (t *SSESingleConnection) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	switch r.Method {
+	case http.MethodPut:
+		t.reserve(w, r)
+	case http.MethodGet:
+		t.stream(w, r)
+	case http.MethodPost:
+		t.execute(w, r, exec)
+	case http.MethodDelete:
+		t.stop(w, r)
+	}
+}
+
+// isStreamReservation reports whether a PUT request reserves a stream: reservations have no body
+// and accept the plain text token, so other PUT routes of the handler are left alone.
+func isStreamReservation(r *http.Request) bool {
+	if r.ContentLength != 0 || r.Header.Get("Content-Type") != "" {
+		return false
+	}
+	accept := r.Header.Get("Accept")
+	return accept == "" || strings.Contains(accept, "text/plain") || strings.Contains(accept, "*/*")
+}
+
+// streamToken reads the token from the header, or from the token query parameter for clients
+// like EventSource that can not set headers.
+func streamToken(r *http.Request) string {
+	if token := r.Header.Get(SSEStreamTokenHeader); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("token")
+}
+
+func (t *SSESingleConnection) reserve(w http.ResponseWriter, r *http.Request) {
+	if GetDrainer(r.Context()).IsDraining() {
+		SendErrorf(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+
+	s := &sseStream{
+		token:  uuid.NewString(),
+		ops:    map[string]*sseOperation{},
+		opDone: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+
+	timeout := t.TokenTimeout
+	if timeout == 0 {
+		timeout = DefaultSSETokenTimeout
+	}
+
+	t.mu.Lock()
+	if t.streams == nil {
+		t.streams = map[string]*sseStream{}
+	}
+	t.streams[s.token] = s
+	s.expiry = time.AfterFunc(timeout, func() { t.expire(s) })
+	t.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = io.WriteString(w, s.token)
+}
+
+func (t *SSESingleConnection) lookup(token string) *sseStream {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.streams[token]
+}
+
+func (t *SSESingleConnection) remove(s *sseStream) {
+	t.mu.Lock()
+	delete(t.streams, s.token)
+	t.mu.Unlock()
+	s.close()
+}
+
+func (t *SSESingleConnection) expire(s *sseStream) {
+	s.mu.Lock()
+	connected := s.connected
+	s.mu.Unlock()
+	if !connected {
+		t.remove(s)
+	}
+}
+
+func (t *SSESingleConnection) stream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	s := t.lookup(streamToken(r))
+	if s == nil {
+		SendErrorf(w, http.StatusNotFound, "stream not found")
+		return
+	}
+
+	drainer := GetDrainer(r.Context())
+	if !drainer.Acquire() {
+		SendErrorf(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+	defer drainer.Release()
+
+	s.mu.Lock()
+	if s.connected || s.closed {
+		s.mu.Unlock()
+		SendErrorf(w, http.StatusConflict, "stream already open")
+		return
+	}
+	s.connected = true
+	s.expiry.Stop()
+
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ":\n\n")
+	for _, event := range s.pending {
+		fmt.Fprint(w, event)
+	}
+	s.pending = nil
+	s.w = w
+	s.f = flusher
+	flusher.Flush()
+	s.mu.Unlock()
+
+	// the token is only good for a single stream, closing the stream stops all its operations.
+	defer t.remove(s)
+
+	var ping <-chan time.Time
+	if t.KeepAlivePingInterval > 0 {
+		ticker := time.NewTicker(t.KeepAlivePingInterval)
+		defer ticker.Stop()
+		ping = ticker.C
+	}
+
+	draining := drainer.Draining()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.done:
+			return
+		case <-draining:
+			// wait for queries and mutations to finish, subscriptions are completed right away.
+			draining = nil
+			if s.drain() {
+				return
+			}
+		case <-s.opDone:
+			if s.idle() {
+				return
+			}
+		case <-ping:
+			s.write(": ping\n\n")
+		}
+	}
+}
+
+func (t *SSESingleConnection) execute(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	w.Header().Set("Content-Type", "application/json")
+
+	s := t.lookup(streamToken(r))
+	if s == nil {
+		SendErrorf(w, http.StatusNotFound, "stream not found")
+		return
+	}
+
+	start := graphql.Now()
+	params := &graphql.RawParams{}
+	if err := jsonDecode(r.Body, params); err != nil {
+		log.Printf("decoding error: %+v", err.Error())
+		SendErrorf(w, http.StatusBadRequest, "json request body could not be decoded: %+v", err)
+		return
+	}
+	params.Headers = r.Header
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	opID, _ := params.Extensions["operationId"].(string)
+	if opID == "" {
+		SendErrorf(w, http.StatusBadRequest, "operationId extension is required")
+		return
+	}
+
+	drainer := GetDrainer(r.Context())
+	if !drainer.Acquire() {
+		SendErrorf(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+
+	// the operation outlives this request, its results are written to the event stream.
+	ctx, cancel := context.WithCancel(context.WithoutCancel(r.Context()))
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	ctx = graphql.WithOperationContext(ctx, rc)
+	if opErr != nil {
+		cancel()
+		drainer.Release()
+		w.WriteHeader(statusFor(opErr))
+		writeJson(w, exec.DispatchError(ctx, opErr))
+		return
+	}
+
+	if err := s.start(opID, &sseOperation{
+		cancel:       cancel,
+		subscription: rc.Operation.Operation == ast.Subscription,
+	}); err != nil {
+		cancel()
+		drainer.Release()
+		SendError(w, http.StatusConflict, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+
+	go func() {
+		defer drainer.Release()
+		defer s.finish(opID)
+
+		responses, ctx := exec.DispatchOperation(ctx, rc)
+		for {
+			response := responses(ctx)
+			if response == nil {
+				break
+			}
+			s.next(opID, response)
+		}
+	}()
+}
+
+func (t *SSESingleConnection) stop(w http.ResponseWriter, r *http.Request) {
+	s := t.lookup(streamToken(r))
+	if s == nil {
+		SendErrorf(w, http.StatusNotFound, "stream not found")
+		return
+	}
+
+	s.cancel(r.URL.Query().Get("operationId"))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *sseStream) start(id string, op *sseOperation) *gqlerror.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return gqlerror.Errorf("stream closed")
+	}
+	if _, ok := s.ops[id]; ok {
+		return gqlerror.Errorf("operation with id %s already running", id)
+	}
+	if s.draining && op.subscription {
+		op.cancel()
+	}
+	s.ops[id] = op
+	return nil
+}
+
+func (s *sseStream) cancel(id string) {
+	s.mu.Lock()
+	op := s.ops[id]
+	s.mu.Unlock()
+	if op != nil {
+		op.cancel()
+	}
+}
+
+func (s *sseStream) finish(id string) {
+	s.mu.Lock()
+	op := s.ops[id]
+	delete(s.ops, id)
+	s.mu.Unlock()
+	if op != nil {
+		op.cancel()
+	}
+
+	s.event("complete", map[string]any{"id": id})
+
+	select {
+	case s.opDone <- struct{}{}:
+	default:
+	}
+}
+
+func (s *sseStream) next(id string, response *graphql.Response) {
+	s.event("next", map[string]any{"id": id, "payload": response})
+}
+
+// drain completes all subscriptions and reports whether the stream is idle.
+func (s *sseStream) drain() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.draining = true
+	for _, op := range s.ops {
+		if op.subscription {
+			op.cancel()
+		}
+	}
+	return len(s.ops) == 0
+}
+
+// idle reports whether the stream is draining and has no operations left.
+func (s *sseStream) idle() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.draining && len(s.ops) == 0
+}
+
+func (s *sseStream) event(event string, data any) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	s.write(fmt.Sprintf("event: %s\ndata: %s\n\n", event, b))
+}
+
+// write sends a raw event, or buffers it until the client connects to the stream.
+func (s *sseStream) write(event string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	if !s.connected {
+		s.pending = append(s.pending, event)
+		return
+	}
+	fmt.Fprint(s.w, event)
+	s.f.Flush()
+}
+
+func (s *sseStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	for _, op := range s.ops {
+		op.cancel()
+	}
+	close(s.done)
+}
@@ -0,0 +1,252 @@
+package transport_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSSESingleConnection(t *testing.T) {
+	initialize := func(tr *transport.SSESingleConnection) (*testserver.TestServer, *httptest.Server) {
+		h := testserver.New()
+		h.AddTransport(tr)
+		return h, httptest.NewServer(h)
+	}
+
+	reserve := func(t *testing.T, url string) string {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPut, url, http.NoBody)
+		require.NoError(t, err)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+		token, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+		return string(token)
+	}
+
+	do := func(t *testing.T, method, url, token, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set(transport.SSEStreamTokenHeader, token)
+		if method == http.MethodGet {
+			req.Header.Set("Accept", "text/event-stream")
+		} else {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return res
+	}
+
+	readLine := func(t *testing.T, br *bufio.Reader) string {
+		t.Helper()
+		bs, err := br.ReadString('\n')
+		require.NoError(t, err)
+		return bs
+	}
+
+	t.Run("multiplexes operations over one stream", func(t *testing.T) {
+		h, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		stream := do(t, http.MethodGet, srv.URL, token, "")
+		defer stream.Body.Close()
+		require.Equal(t, http.StatusOK, stream.StatusCode)
+		assert.Equal(t, "text/event-stream", stream.Header.Get("Content-Type"))
+
+		br := bufio.NewReader(stream.Body)
+		assert.Equal(t, ":\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+
+		res := do(t, http.MethodPost, srv.URL, token, `{"query":"{ name }","extensions":{"operationId":"q"}}`)
+		res.Body.Close()
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		assert.Equal(t, "event: next\n", readLine(t, br))
+		assert.Equal(t, "data: {\"id\":\"q\",\"payload\":{\"data\":{\"name\":\"test\"}}}\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+		assert.Equal(t, "event: complete\n", readLine(t, br))
+		assert.Equal(t, "data: {\"id\":\"q\"}\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+
+		res = do(t, http.MethodPost, srv.URL, token, `{"query":"subscription { name }","extensions":{"operationId":"s"}}`)
+		res.Body.Close()
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		h.SendNextSubscriptionMessage()
+		assert.Equal(t, "event: next\n", readLine(t, br))
+		assert.Equal(t, "data: {\"id\":\"s\",\"payload\":{\"data\":{\"name\":\"test\"}}}\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+
+		res = do(t, http.MethodPost, srv.URL, token, `{"query":"subscription { name }","extensions":{"operationId":"s"}}`)
+		res.Body.Close()
+		require.Equal(t, http.StatusConflict, res.StatusCode)
+
+		res = do(t, http.MethodDelete, srv.URL+"?operationId=s", token, "")
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		assert.Equal(t, "event: complete\n", readLine(t, br))
+		assert.Equal(t, "data: {\"id\":\"s\"}\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+	})
+
+	t.Run("operation errors are returned from the post", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+
+		res := do(t, http.MethodPost, srv.URL, token, `{"query":"{ name }"}`)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+		b, _ := io.ReadAll(res.Body)
+		assert.JSONEq(t, `{"errors":[{"message":"operationId extension is required"}],"data":null}`, string(b))
+
+		res = do(t, http.MethodPost, srv.URL, token, `{"query":"{ nope }","extensions":{"operationId":"q"}}`)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	})
+
+	t.Run("only body-less PUT requests reserve streams", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		res := do(t, http.MethodPut, srv.URL, "", `{"name":"bob"}`)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		req, err := http.NewRequest(http.MethodPut, srv.URL, http.NoBody)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/json")
+		res, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		res := do(t, http.MethodGet, srv.URL, "nope", "")
+		defer res.Body.Close()
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("token is only valid for one stream", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		stream := do(t, http.MethodGet, srv.URL, token, "")
+		defer stream.Body.Close()
+		require.Equal(t, http.StatusOK, stream.StatusCode)
+
+		second := do(t, http.MethodGet, srv.URL, token, "")
+		defer second.Body.Close()
+		require.Equal(t, http.StatusConflict, second.StatusCode)
+	})
+
+	t.Run("token expires when stream is not opened", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{TokenTimeout: 5 * time.Millisecond})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		require.Eventually(t, func() bool {
+			res := do(t, http.MethodPost, srv.URL, token, `{"query":"{ name }","extensions":{"operationId":"q"}}`)
+			res.Body.Close()
+			return res.StatusCode == http.StatusNotFound
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("events before the stream opens are buffered", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		res := do(t, http.MethodPost, srv.URL, token, `{"query":"{ name }","extensions":{"operationId":"q"}}`)
+		res.Body.Close()
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		stream := do(t, http.MethodGet, srv.URL+"?token="+token, "", "")
+		defer stream.Body.Close()
+		br := bufio.NewReader(stream.Body)
+		assert.Equal(t, ":\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+		assert.Equal(t, "event: next\n", readLine(t, br))
+	})
+
+	t.Run("client", func(t *testing.T) {
+		h, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		c := client.New(h)
+		stream, err := c.SSEStream(context.Background())
+		require.NoError(t, err)
+		defer stream.Close()
+
+		sub := stream.Subscribe(`subscription { name }`)
+
+		var resp map[string]any
+		query := stream.Subscribe(`query { name }`)
+		require.NoError(t, query.Next(&resp))
+		require.Equal(t, map[string]any{"name": "test"}, resp["data"])
+		require.NoError(t, query.Next(&resp))
+
+		h.SendNextSubscriptionMessage()
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, map[string]any{"name": "test"}, resp["data"])
+
+		require.NoError(t, sub.Close())
+		require.NoError(t, sub.Next(&resp))
+	})
+
+	t.Run("drain completes subscriptions and closes the stream", func(t *testing.T) {
+		h, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		stream := do(t, http.MethodGet, srv.URL, token, "")
+		defer stream.Body.Close()
+		br := bufio.NewReader(stream.Body)
+		assert.Equal(t, ":\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+
+		res := do(t, http.MethodPost, srv.URL, token, `{"query":"subscription { name }","extensions":{"operationId":"s"}}`)
+		res.Body.Close()
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		require.NoError(t, h.Drain(context.Background()))
+
+		assert.Equal(t, "event: complete\n", readLine(t, br))
+		assert.Equal(t, "data: {\"id\":\"s\"}\n", readLine(t, br))
+		assert.Equal(t, "\n", readLine(t, br))
+		_, err := br.ReadByte()
+		assert.Equal(t, io.EOF, err)
+
+		req, err := http.NewRequest(http.MethodPut, srv.URL, http.NoBody)
+		require.NoError(t, err)
+		res, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	})
+}
@@ -2,6 +2,7 @@ package transport_test
 
 import (
 	"bufio"
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -220,4 +221,33 @@ func TestSSE(t *testing.T) {
 
 		wg.Wait()
 	})
+	t.Run("drain completes subscription and refuses new ones", func(t *testing.T) {
+		handler, srv := initializeWithServer()
+		defer srv.Close()
+
+		req := createHTTPRequest(srv.URL, `{"query":"subscription { name }"}`)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		br := bufio.NewReader(res.Body)
+		assert.Equal(t, ":\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+
+		drained := make(chan error)
+		go func() {
+			drained <- handler.Drain(context.Background())
+		}()
+
+		assert.Equal(t, "event: complete\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+		require.NoError(t, <-drained)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, createHTTPTestRequest(`{"query":"subscription { name }"}`))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.JSONEq(t, `{"errors":[{"message":"server is shutting down"}],"data":null}`, w.Body.String())
+	})
 }
@@ -0,0 +1,187 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrFileTooLarge is returned while reading an upload that exceeds MultipartForm.MaxFileSize.
+var ErrFileTooLarge = errors.New("file exceeds the maximum upload size")
+
+type (
+	// UploadFile describes a file part of a multipart request.
+	UploadFile struct {
+		// Key is the name of the form field, as referenced by the map field.
+		Key         string
+		Filename    string
+		ContentType string
+	}
+
+	// StoredUpload is a file saved by an UploadStore.
+	StoredUpload interface {
+		// Size returns the number of bytes stored.
+		Size() int64
+		// Open returns a new reader positioned at the start of the file. It is called once for
+		// every variable the file is mapped to. Readers implementing io.Closer are closed once
+		// the response has been written.
+		Open(ctx context.Context) (io.ReadSeeker, error)
+	}
+
+	// UploadStore receives the files of a multipart request while the request body is read, in
+	// place of MultipartForm's default of buffering them in memory or temporary files.
+	UploadStore interface {
+		// Save consumes r and stores its content. r fails with ErrFileTooLarge when the file is
+		// larger than MultipartForm.MaxFileSize, Save should return that error unchanged.
+		Save(ctx context.Context, file UploadFile, r io.Reader) (StoredUpload, error)
+	}
+)
+
+// MemoryUploadStore keeps uploaded files in memory. Files are released with the request.
+type MemoryUploadStore struct{}
+
+var _ UploadStore = MemoryUploadStore{}
+
+func (MemoryUploadStore) Save(ctx context.Context, file UploadFile, r io.Reader) (StoredUpload, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return memoryUpload(b), nil
+}
+
+type memoryUpload []byte
+
+func (m memoryUpload) Size() int64 {
+	return int64(len(m))
+}
+
+func (m memoryUpload) Open(ctx context.Context) (io.ReadSeeker, error) {
+	b := []byte(m)
+	return &bytesReader{s: &b}, nil
+}
+
+// DiskUploadStore writes uploaded files to Dir. Unlike the temporary files used by MultipartForm
+// by default the files are kept after the request, resolvers can find their location with
+// (*os.File).Name on the Upload's File.
+type DiskUploadStore struct {
+	// Dir is the directory files are written to, it defaults to os.TempDir.
+	Dir string
+	// Pattern is passed to os.CreateTemp to name the files, it defaults to "gqlgen-upload-*".
+	Pattern string
+}
+
+var _ UploadStore = DiskUploadStore{}
+
+func (d DiskUploadStore) Save(ctx context.Context, file UploadFile, r io.Reader) (StoredUpload, error) {
+	dir := d.Dir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	pattern := d.Pattern
+	if pattern == "" {
+		pattern = "gqlgen-upload-*"
+	}
+
+	f, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+	size, err := io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return nil, err
+	}
+	return diskUpload{name: f.Name(), size: size}, nil
+}
+
+type diskUpload struct {
+	name string
+	size int64
+}
+
+func (d diskUpload) Size() int64 {
+	return d.size
+}
+
+func (d diskUpload) Open(ctx context.Context) (io.ReadSeeker, error) {
+	return os.Open(d.name)
+}
+
+// tempUploadStore is the default store for files bigger than MultipartForm.MaxMemory, the files
+// are removed again once the request is done.
+type tempUploadStore struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (t *tempUploadStore) Save(ctx context.Context, file UploadFile, r io.Reader) (StoredUpload, error) {
+	stored, err := DiskUploadStore{Pattern: "gqlgen-"}.Save(ctx, file, r)
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	t.names = append(t.names, stored.(diskUpload).name)
+	t.mu.Unlock()
+	return stored, nil
+}
+
+func (t *tempUploadStore) cleanup() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, name := range t.names {
+		_ = os.Remove(name)
+	}
+	t.names = nil
+}
+
+// maxSizeReader fails with ErrFileTooLarge once more than n bytes have been read.
+type maxSizeReader struct {
+	r io.Reader
+	n int64
+}
+
+func (m *maxSizeReader) Read(p []byte) (int, error) {
+	if m.n < 0 {
+		return 0, ErrFileTooLarge
+	}
+	if int64(len(p)) > m.n+1 {
+		p = p[:m.n+1]
+	}
+	n, err := m.r.Read(p)
+	m.n -= int64(n)
+	if m.n < 0 {
+		return n, ErrFileTooLarge
+	}
+	return n, err
+}
+
+// contentTypeAllowed reports whether contentType matches one of allowed, entries may be full
+// media types or wildcards like "image/*". An empty allowed list accepts everything.
+func contentTypeAllowed(allowed []string, contentType string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, a := range allowed {
+		if a == "*/*" || strings.EqualFold(a, mediaType) {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok &&
+			strings.HasPrefix(mediaType, strings.ToLower(prefix)+"/") {
+			return true
+		}
+	}
+	return false
+}
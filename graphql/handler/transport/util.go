@@ -1,16 +1,23 @@
 package transport
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
 )
 
 func writeJson(w io.Writer, response *graphql.Response) {
+	if response.DataWriter != nil {
+		writeStreamedJson(w, response)
+		return
+	}
 	b, err := json.Marshal(response)
 	if err != nil {
 		panic(fmt.Errorf("unable to marshal %s: %w", string(response.Data), err))
@@ -18,6 +25,55 @@ func writeJson(w io.Writer, response *graphql.Response) {
 	w.Write(b)
 }
 
+// responseTail holds the fields of a streamed response written after its data.
+type responseTail struct {
+	Errors     gqlerror.List  `json:"errors,omitempty"`
+	Label      string         `json:"label,omitempty"`
+	Path       ast.Path       `json:"path,omitempty"`
+	HasNext    *bool          `json:"hasNext,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+var writerPool = sync.Pool{
+	New: func() any {
+		return bufio.NewWriterSize(nil, 4096)
+	},
+}
+
+// writeStreamedJson writes a streamed response, its data is written by its DataWriter through a
+// pooled buffered writer. Errors follow the data, as writing the data can raise more of them.
+func writeStreamedJson(w io.Writer, response *graphql.Response) {
+	bw := writerPool.Get().(*bufio.Writer)
+	bw.Reset(w)
+	defer func() {
+		bw.Reset(nil)
+		writerPool.Put(bw)
+	}()
+
+	_, _ = bw.WriteString(`{"data":`)
+	errs := response.Errors
+	if dataErrs := response.DataWriter(bw); len(dataErrs) > 0 {
+		errs = append(errs[:len(errs):len(errs)], dataErrs...)
+	}
+
+	b, err := json.Marshal(responseTail{
+		Errors:     errs,
+		Label:      response.Label,
+		Path:       response.Path,
+		HasNext:    response.HasNext,
+		Extensions: response.Extensions,
+	})
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal streamed response: %w", err))
+	}
+	if len(b) > len(`{}`) {
+		_ = bw.WriteByte(',')
+		_, _ = bw.Write(b[1 : len(b)-1])
+	}
+	_ = bw.WriteByte('}')
+	_ = bw.Flush()
+}
+
 func writeJsonError(w io.Writer, msg string) {
 	writeJson(w, &graphql.Response{Errors: gqlerror.List{{Message: msg}}})
 }
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -46,7 +47,9 @@ type (
 		ctx             context.Context
 		conn            *websocket.Conn
 		me              messageExchanger
-		active          map[string]context.CancelFunc
+		active          map[string]*wsOperation
+		ops             sync.WaitGroup
+		drainer         *Drainer
 		mu              sync.Mutex
 		keepAliveTicker *time.Ticker
 		pongOnlyTicker  *time.Ticker
@@ -59,6 +62,11 @@ type (
 		initPayload InitPayload
 	}
 
+	wsOperation struct {
+		cancel       context.CancelFunc
+		subscription bool
+	}
+
 	WebsocketInitFunc  func(ctx context.Context, initPayload InitPayload) (context.Context, *InitPayload, error)
 	WebsocketErrorFunc func(ctx context.Context, err error)
 
@@ -92,6 +100,12 @@ func (t Websocket) Supports(r *http.Request) bool {
 }
 
 func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	drainer := GetDrainer(r.Context())
+	if drainer.IsDraining() {
+		SendErrorf(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+
 	t.injectGraphQLWSSubprotocols()
 	ws, err := t.Upgrader.Upgrade(w, r, http.Header{})
 	if err != nil {
@@ -119,7 +133,8 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 	}
 
 	conn := wsConnection{
-		active:    map[string]context.CancelFunc{},
+		active:    map[string]*wsOperation{},
+		drainer:   drainer,
 		conn:      ws,
 		ctx:       r.Context(),
 		exec:      exec,
@@ -287,6 +302,9 @@ func (c *wsConnection) run() {
 	// Will optionally send a "close reason" that is retrieved from the context.
 	go c.closeOnCancel(ctx)
 
+	// Complete subscriptions and ask the client to reconnect when the server drains.
+	go c.closeOnDrain(ctx)
+
 	for {
 		start := graphql.Now()
 		m, err := c.me.NextMessage()
@@ -303,10 +321,10 @@ func (c *wsConnection) run() {
 			c.subscribe(start, &m)
 		case stopMessageType:
 			c.mu.Lock()
-			closer := c.active[m.id]
+			op := c.active[m.id]
 			c.mu.Unlock()
-			if closer != nil {
-				closer()
+			if op != nil {
+				op.cancel()
 			}
 		case connectionCloseMessageType:
 			c.close(websocket.CloseNormalClosure, "terminated")
@@ -380,6 +398,27 @@ func (c *wsConnection) closeOnCancel(ctx context.Context) {
 	c.close(websocket.CloseNormalClosure, "terminated")
 }
 
+func (c *wsConnection) closeOnDrain(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-c.drainer.Draining():
+	}
+
+	// New operations are refused from here on, so ops can only go down. Queries and mutations
+	// are left to finish, subscriptions are cancelled which makes them send complete.
+	c.mu.Lock()
+	for _, op := range c.active {
+		if op.subscription {
+			op.cancel()
+		}
+	}
+	c.mu.Unlock()
+	c.ops.Wait()
+
+	c.close(websocket.CloseGoingAway, GoingAwayCloseReason)
+}
+
 func (c *wsConnection) subscribe(start time.Time, msg *message) {
 	ctx := graphql.StartOperationTrace(c.ctx)
 	var params *graphql.RawParams
@@ -418,7 +457,18 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 
 	ctx, cancel := context.WithCancel(ctx)
 	c.mu.Lock()
-	c.active[msg.id] = cancel
+	if !c.drainer.Acquire() {
+		c.mu.Unlock()
+		cancel()
+		c.sendError(msg.id, &gqlerror.Error{Message: "server is shutting down"})
+		c.complete(msg.id)
+		return
+	}
+	c.ops.Add(1)
+	c.active[msg.id] = &wsOperation{
+		cancel:       cancel,
+		subscription: rc.Operation.Operation == ast.Subscription,
+	}
 	c.mu.Unlock()
 
 	go func() {
@@ -444,6 +494,8 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 			delete(c.active, msg.id)
 			c.mu.Unlock()
 			cancel()
+			c.ops.Done()
+			c.drainer.Release()
 		}()
 
 		responses, ctx := c.exec.DispatchOperation(ctx, rc)
@@ -507,8 +559,8 @@ func (c *wsConnection) close(closeCode int, message string) {
 		websocket.CloseMessage,
 		websocket.FormatCloseMessage(closeCode, message),
 	)
-	for _, closer := range c.active {
-		closer()
+	for _, op := range c.active {
+		op.cancel()
 	}
 	c.closed = true
 	c.mu.Unlock()
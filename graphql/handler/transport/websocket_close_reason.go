@@ -12,6 +12,10 @@ type wsCloseReasonContextKey struct {
 	name string
 }
 
+// GoingAwayCloseReason is sent along with a 1001 (going away) close frame when the server drains
+// its websocket connections before shutting down. Clients are expected to reconnect.
+const GoingAwayCloseReason = "going away, reconnect"
+
 func AppendCloseReason(ctx context.Context, reason string) context.Context {
 	return context.WithValue(ctx, closeReasonCtxKey, reason)
 }
@@ -732,6 +732,107 @@ func TestWebsocketGraphqltransportwsSubprotocol(t *testing.T) {
 	})
 }
 
+func TestWebsocketDrain(t *testing.T) {
+	initialize := func() (*testserver.TestServer, *httptest.Server) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{})
+		return h, httptest.NewServer(h)
+	}
+
+	t.Run("completes subscriptions and closes with going away", func(t *testing.T) {
+		var closeCode int
+		closed := make(chan struct{})
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			CloseFunc: func(_ context.Context, code int) {
+				closeCode = code
+				close(closed)
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(
+			t,
+			c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}),
+		)
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+
+		h.SendNextSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+
+		drained := make(chan error)
+		go func() {
+			drained <- h.Drain(context.Background())
+		}()
+
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+
+		_, _, err := c.ReadMessage()
+		var closeErr *websocket.CloseError
+		require.ErrorAs(t, err, &closeErr)
+		assert.Equal(t, websocket.CloseGoingAway, closeErr.Code)
+		assert.Equal(t, transport.GoingAwayCloseReason, closeErr.Text)
+
+		require.NoError(t, <-drained)
+		<-closed
+		assert.Equal(t, websocket.CloseGoingAway, closeCode)
+	})
+
+	t.Run("refuses new connections", func(t *testing.T) {
+		h, srv := initialize()
+		defer srv.Close()
+
+		require.NoError(t, h.Drain(context.Background()))
+
+		_, resp, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(srv.URL, "http://", "ws://"), nil)
+		require.Error(t, err)
+		require.NotNil(t, resp)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	})
+
+	t.Run("times out waiting for operations", func(t *testing.T) {
+		h, srv := initialize()
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		// queries are allowed to finish, the test server blocks deferred queries until it is told
+		// to complete them.
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "query { ... @defer { name } }"}`),
+		}))
+		time.Sleep(10 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, h.Drain(ctx), context.DeadlineExceeded)
+
+		h.SendCompleteSubscriptionMessage()
+		require.NoError(t, h.Drain(context.Background()))
+	})
+}
+
 func TestWebsocketWithPingPongInterval(t *testing.T) {
 	initialize := func(ws transport.Websocket) (*testserver.TestServer, *httptest.Server) {
 		h := testserver.New()
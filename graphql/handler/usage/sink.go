@@ -0,0 +1,89 @@
+package usage
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// Sink records the schema coordinates used by the operations of clients.
+type Sink interface {
+	// Record is called once per operation with the coordinates it uses, sorted. It must not
+	// modify them.
+	Record(ctx context.Context, client Client, coordinates []string)
+}
+
+// ClientUsage is the number of operations of a client using each schema coordinate.
+type ClientUsage struct {
+	Client      Client           `json:"client"`
+	Coordinates map[string]int64 `json:"coordinates"`
+}
+
+// MemorySink counts in memory the operations using each schema coordinate per client. It is an
+// http.Handler writing these counts as JSON. The zero value is ready to use.
+type MemorySink struct {
+	mu     sync.Mutex
+	counts map[Client]map[string]int64
+}
+
+var (
+	_ Sink         = &MemorySink{}
+	_ http.Handler = &MemorySink{}
+)
+
+// NewMemorySink returns an empty MemorySink.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{counts: map[Client]map[string]int64{}}
+}
+
+func (s *MemorySink) Record(ctx context.Context, client Client, coordinates []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.counts == nil {
+		s.counts = map[Client]map[string]int64{}
+	}
+	counts, ok := s.counts[client]
+	if !ok {
+		counts = map[string]int64{}
+		s.counts[client] = counts
+	}
+	for _, coordinate := range coordinates {
+		counts[coordinate]++
+	}
+}
+
+// Usage returns a copy of the counts, sorted by client.
+func (s *MemorySink) Usage() []ClientUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := make([]ClientUsage, 0, len(s.counts))
+	for client, counts := range s.counts {
+		coordinates := make(map[string]int64, len(counts))
+		for coordinate, count := range counts {
+			coordinates[coordinate] = count
+		}
+		usage = append(usage, ClientUsage{Client: client, Coordinates: coordinates})
+	}
+	slices.SortFunc(usage, func(a, b ClientUsage) int {
+		return cmp.Or(cmp.Compare(a.Client.Name, b.Client.Name), cmp.Compare(a.Client.Version, b.Client.Version))
+	})
+	return usage
+}
+
+// Reset clears the counts.
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.counts)
+}
+
+// ServeHTTP writes the counts returned by Usage as JSON.
+func (s *MemorySink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.Usage())
+}
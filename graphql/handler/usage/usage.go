@@ -0,0 +1,254 @@
+// Package usage records the schema coordinates used by the operations of each client, to find out
+// who still uses deprecated fields before removing them.
+package usage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+)
+
+const (
+	defaultClientNameHeader    = "apollographql-client-name"
+	defaultClientVersionHeader = "apollographql-client-version"
+	defaultCacheSize           = 1000
+)
+
+// Client identifies the client of an operation.
+type Client struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Tracker records the schema coordinates used by every operation in its Sink, once the operation
+// is validated:
+//
+//   - Type.field for the fields selected;
+//   - Type.field(arg:) for the arguments passed;
+//   - Input.field for the fields of the input objects passed, in the document or in variables;
+//   - Enum.VALUE for the enum values passed, in the document or in variables.
+//
+// The coordinates of a document are collected once and cached by the hash of the schema, the
+// document and the name of the operation. Only the coordinates found in variables are collected for each
+// operation.
+type Tracker struct {
+	// Sink records the coordinates, it is required.
+	Sink Sink
+
+	// ClientNameHeader and ClientVersionHeader are the headers of the requests holding the name
+	// and the version of the clients, apollographql-client-name and apollographql-client-version
+	// when empty.
+	ClientNameHeader    string
+	ClientVersionHeader string
+
+	// Cache holds the coordinates of documents, an LRU cache of 1000 documents when nil.
+	Cache graphql.Cache[[]string]
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &Tracker{}
+
+func (t Tracker) ExtensionName() string {
+	return "FieldUsage"
+}
+
+func (t *Tracker) Validate(schema graphql.ExecutableSchema) error {
+	if t.Sink == nil {
+		return errors.New("usage.Tracker.Sink can not be nil")
+	}
+	if t.ClientNameHeader == "" {
+		t.ClientNameHeader = defaultClientNameHeader
+	}
+	if t.ClientVersionHeader == "" {
+		t.ClientVersionHeader = defaultClientVersionHeader
+	}
+	if t.Cache == nil {
+		t.Cache = lru.New[[]string](defaultCacheSize)
+	}
+	t.es = schema
+	return nil
+}
+
+func (t *Tracker) MutateOperationContext(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+
+	schema := graphql.OperationSchema(opCtx, t.es).Schema()
+	key := documentKey(schema, opCtx.RawQuery, opCtx.OperationName)
+	coordinates, ok := t.Cache.Get(ctx, key)
+	if !ok {
+		c := collector{coordinates: map[string]bool{}, fragments: map[string]bool{}}
+		c.selectionSet(opCtx.Operation.SelectionSet)
+		coordinates = c.sorted()
+		t.Cache.Add(ctx, key, coordinates)
+	}
+
+	if len(opCtx.Operation.VariableDefinitions) > 0 {
+		c := collector{coordinates: map[string]bool{}}
+		for _, def := range opCtx.Operation.VariableDefinitions {
+			if value, ok := opCtx.Variables[def.Variable]; ok {
+				c.variable(schema, def.Type, value)
+			}
+		}
+		if len(c.coordinates) > 0 {
+			for _, coordinate := range coordinates {
+				c.coordinates[coordinate] = true
+			}
+			coordinates = c.sorted()
+		}
+	}
+
+	t.Sink.Record(ctx, Client{
+		Name:    opCtx.Headers.Get(t.ClientNameHeader),
+		Version: opCtx.Headers.Get(t.ClientVersionHeader),
+	}, coordinates)
+	return nil
+}
+
+// documentKey identifies the schema too, the versions of a handler.Router share their extensions
+// and a document can select different types in each of them.
+func documentKey(schema *ast.Schema, query, operationName string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%p", schema)
+	h.Write([]byte{0})
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write([]byte(operationName))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// collector collects the schema coordinates of an operation.
+type collector struct {
+	coordinates map[string]bool
+	fragments   map[string]bool
+}
+
+func (c *collector) sorted() []string {
+	coordinates := make([]string, 0, len(c.coordinates))
+	for coordinate := range c.coordinates {
+		coordinates = append(coordinates, coordinate)
+	}
+	slices.Sort(coordinates)
+	return coordinates
+}
+
+func (c *collector) selectionSet(set ast.SelectionSet) {
+	for _, selection := range set {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			c.field(selection)
+		case *ast.InlineFragment:
+			c.selectionSet(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if c.fragments[selection.Name] || selection.Definition == nil {
+				continue
+			}
+			c.fragments[selection.Name] = true
+			c.selectionSet(selection.Definition.SelectionSet)
+		}
+	}
+}
+
+func (c *collector) field(field *ast.Field) {
+	if field.ObjectDefinition == nil || strings.HasPrefix(field.Name, "__") {
+		return
+	}
+	coordinate := field.ObjectDefinition.Name + "." + field.Name
+	c.coordinates[coordinate] = true
+	for _, arg := range field.Arguments {
+		c.coordinates[coordinate+"("+arg.Name+":)"] = true
+		c.value(arg.Value)
+	}
+	c.selectionSet(field.SelectionSet)
+}
+
+// value adds the input fields and the enum values of a value of the document.
+func (c *collector) value(value *ast.Value) {
+	if value == nil {
+		return
+	}
+	switch value.Kind {
+	case ast.ObjectValue:
+		for _, child := range value.Children {
+			if value.Definition != nil {
+				c.coordinates[value.Definition.Name+"."+child.Name] = true
+			}
+			c.value(child.Value)
+		}
+	case ast.ListValue:
+		for _, child := range value.Children {
+			c.value(child.Value)
+		}
+	case ast.EnumValue:
+		if value.Definition != nil {
+			c.coordinates[value.Definition.Name+"."+value.Raw] = true
+		}
+	}
+}
+
+// variable adds the input fields and the enum values of the value of a variable of type typ.
+func (c *collector) variable(schema *ast.Schema, typ *ast.Type, value any) {
+	if typ.Elem != nil {
+		if items, ok := value.([]any); ok {
+			for _, item := range items {
+				c.variable(schema, typ.Elem, item)
+			}
+			return
+		}
+		// a single value is coerced to a list of one item
+		c.variable(schema, typ.Elem, value)
+		return
+	}
+
+	def := schema.Types[typ.NamedType]
+	if def == nil {
+		return
+	}
+	switch def.Kind {
+	case ast.InputObject:
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		for name, field := range fields {
+			fieldDef := def.Fields.ForName(name)
+			if fieldDef == nil {
+				continue
+			}
+			c.coordinates[def.Name+"."+name] = true
+			c.variable(schema, fieldDef.Type, field)
+		}
+	case ast.Enum:
+		if name, ok := enumName(value); ok {
+			c.coordinates[def.Name+"."+name] = true
+		}
+	}
+}
+
+func enumName(value any) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case interface{ String() string }:
+		return value.String(), true
+	}
+	return "", false
+}
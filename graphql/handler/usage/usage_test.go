@@ -0,0 +1,164 @@
+package usage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/usage"
+)
+
+var schema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+	type Query {
+		user(id: ID!): User
+		users(filter: UserFilter, role: Role): [User!]!
+	}
+
+	type User {
+		id: ID!
+		name: String!
+		login: String! @deprecated(reason: "use name")
+		role: Role!
+	}
+
+	input UserFilter {
+		name: String
+		roles: [Role!]
+	}
+
+	enum Role {
+		ADMIN
+		MEMBER
+		GUEST
+	}
+`})
+
+func TestTracker(t *testing.T) {
+	sink := usage.NewMemorySink()
+	cache := graphql.MapCache[[]string]{}
+	tracker := &usage.Tracker{Sink: sink, ClientVersionHeader: "X-Client-Version", Cache: cache}
+	require.NoError(t, tracker.Validate(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+	}))
+
+	run := func(query string, variables map[string]any, headers http.Header) {
+		t.Helper()
+		doc := gqlparser.MustLoadQuery(schema, query)
+		op := doc.Operations[0]
+		vars, err := validator.VariableValues(schema, op, variables)
+		require.NoError(t, err)
+		require.Nil(t, tracker.MutateOperationContext(context.Background(), &graphql.OperationContext{
+			RawQuery:  query,
+			Doc:       doc,
+			Operation: op,
+			Variables: vars,
+			Headers:   headers,
+		}))
+	}
+
+	web := http.Header{}
+	web.Set("Apollographql-Client-Name", "web")
+	web.Set("X-Client-Version", "1.2.0")
+
+	const query = `
+		query Users($filter: UserFilter) {
+			users(filter: $filter, role: ADMIN) { ...User }
+			user(id: "1") { login __typename }
+		}
+		fragment User on User { id name }
+	`
+	run(query, map[string]any{"filter": map[string]any{"roles": []any{"MEMBER", "GUEST"}}}, web)
+	run(query, nil, web)
+	run(`{ users(filter: {name: "gopher", roles: [GUEST]}) { role } }`, nil, nil)
+
+	require.Len(t, cache, 2)
+	require.Equal(t, []usage.ClientUsage{
+		{
+			Client: usage.Client{},
+			Coordinates: map[string]int64{
+				"Query.users":          1,
+				"Query.users(filter:)": 1,
+				"UserFilter.name":      1,
+				"UserFilter.roles":     1,
+				"Role.GUEST":           1,
+				"User.role":            1,
+			},
+		},
+		{
+			Client: usage.Client{Name: "web", Version: "1.2.0"},
+			Coordinates: map[string]int64{
+				"Query.user":           2,
+				"Query.user(id:)":      2,
+				"Query.users":          2,
+				"Query.users(filter:)": 2,
+				"Query.users(role:)":   2,
+				"Role.ADMIN":           2,
+				"Role.GUEST":           1,
+				"Role.MEMBER":          1,
+				"User.id":              2,
+				"User.login":           2,
+				"User.name":            2,
+				"UserFilter.roles":     1,
+			},
+		},
+	}, sink.Usage())
+
+	t.Run("handler", func(t *testing.T) {
+		sink.Reset()
+		run(`{ user(id: "1") { id } }`, nil, web)
+
+		w := httptest.NewRecorder()
+		sink.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/usage", nil))
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		require.JSONEq(t, `[{"client":{"name":"web","version":"1.2.0"},"coordinates":{"Query.user":1,"Query.user(id:)":1,"User.id":1}}]`, w.Body.String())
+	})
+}
+
+func TestTrackerSchemaVersions(t *testing.T) {
+	v2 := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query {
+			user(id: ID!): Member
+		}
+
+		type Member {
+			id: ID!
+		}
+	`})
+	sink := usage.NewMemorySink()
+	tracker := &usage.Tracker{Sink: sink}
+	require.NoError(t, tracker.Validate(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+	}))
+
+	const query = `{ user(id: "1") { id } }`
+	for _, s := range []*ast.Schema{schema, v2} {
+		doc := gqlparser.MustLoadQuery(s, query)
+		require.Nil(t, tracker.MutateOperationContext(context.Background(), &graphql.OperationContext{
+			RawQuery:         query,
+			Doc:              doc,
+			Operation:        doc.Operations[0],
+			ExecutableSchema: &graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return s }},
+		}))
+	}
+
+	require.Equal(t, []usage.ClientUsage{{
+		Coordinates: map[string]int64{
+			"Query.user":      2,
+			"Query.user(id:)": 2,
+			"User.id":         1,
+			"Member.id":       1,
+		},
+	}}, sink.Usage())
+}
+
+func TestTrackerRequiresSink(t *testing.T) {
+	tracker := &usage.Tracker{}
+	require.EqualError(t, tracker.Validate(&graphql.ExecutableSchemaMock{}), "usage.Tracker.Sink can not be nil")
+}
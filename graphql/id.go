@@ -34,7 +34,9 @@ func UnmarshalID(v any) (string, error) {
 
 func MarshalIntID(i int) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		writeQuotedString(w, strconv.Itoa(i))
+		_, _ = io.WriteString(w, `"`)
+		writeInt(w, int64(i))
+		_, _ = io.WriteString(w, `"`)
 	})
 }
 
@@ -55,7 +57,9 @@ func UnmarshalIntID(v any) (int, error) {
 
 func MarshalUintID(i uint) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		writeQuotedString(w, strconv.FormatUint(uint64(i), 10))
+		_, _ = io.WriteString(w, `"`)
+		writeUint(w, uint64(i))
+		_, _ = io.WriteString(w, `"`)
 	})
 }
 
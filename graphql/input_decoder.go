@@ -0,0 +1,402 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// VariablesDecoder is implemented by the executable schemas generated with exec.input_decoders.
+// They decode the variables of an operation straight from the JSON they were sent in, without
+// decoding them with encoding/json first.
+type VariablesDecoder interface {
+	DecodeVariables(ctx context.Context, op *ast.OperationDefinition, raw json.RawMessage) (map[string]any, error)
+}
+
+// InputDecodeFunc decodes the next value of d, an object of the input type name, with the
+// decoder generated for it. It reports false without reading anything when name has none.
+type InputDecodeFunc func(name string, d *InputDecoder) (any, bool, error)
+
+// DecodeVariables reads the variables of op from raw and coerces and validates them with
+// validator.VariableValues, so they are the same maps they are without exec.input_decoders.
+// The input objects decode has a decoder for are read into *DecodedInput values too, they are
+// kept in the operation context of ctx for the generated unmarshalInput functions, see
+// DecodedInputFor.
+func DecodeVariables(ctx context.Context, schema *ast.Schema, op *ast.OperationDefinition, raw json.RawMessage, decode InputDecodeFunc) (map[string]any, error) {
+	d := &InputDecoder{
+		data:   raw,
+		schema: schema,
+		decode: decode,
+	}
+	vars := make(map[string]any, len(op.VariableDefinitions))
+
+	if d.peek() != 0 && !d.literal("null") {
+		err := d.Object(func(name string) error {
+			def := op.VariableDefinitions.ForName(name)
+			if def == nil {
+				return d.skip()
+			}
+			v, err := d.Value(def.Type)
+			vars[name] = v
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if d.peek() != 0 {
+		return nil, d.syntaxError()
+	}
+
+	vars, err := validator.VariableValues(schema, op, vars)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.inputs) > 0 && HasOperationContext(ctx) {
+		GetOperationContext(ctx).decodedInputs = d.inputs
+	}
+	return vars, nil
+}
+
+// DecodedInputFor returns the input object the generated decoder read obj from, when obj is the
+// map of an input object of the variables of the operation of ctx. It returns nil for the other
+// values, like objects written in the query or maps replaced by an extension.
+func DecodedInputFor(ctx context.Context, obj any) *DecodedInput {
+	m, ok := obj.(map[string]any)
+	if !ok {
+		return nil
+	}
+	opCtx, _ := ctx.Value(operationCtx).(*OperationContext)
+	if opCtx == nil || opCtx.decodedInputs == nil {
+		return nil
+	}
+	return opCtx.decodedInputs[mapKey(m)]
+}
+
+// mapKey identifies a map by the address of its header, which stays the same for its lifetime.
+func mapKey(m map[string]any) uintptr {
+	return reflect.ValueOf(m).Pointer()
+}
+
+// InputDecoder reads input values from a JSON document. The values are validated once they are
+// all read, by validator.VariableValues.
+type InputDecoder struct {
+	data   []byte
+	pos    int
+	schema *ast.Schema
+	decode InputDecodeFunc
+	inputs map[uintptr]*DecodedInput
+}
+
+// Value reads the next value, a value of typ, like encoding/json reads it into an any with
+// numbers kept as json.Number. Input objects with a generated decoder are read into
+// *DecodedInput values, Value returns their maps.
+func (d *InputDecoder) Value(typ *ast.Type) (any, error) {
+	if d.peek() == 'n' && d.literal("null") {
+		return nil, nil
+	}
+
+	if typ.Elem != nil {
+		if d.peek() != '[' {
+			// a single value is coerced to a list of one value by the validator
+			return d.Value(typ.Elem)
+		}
+		list := []any{}
+		err := d.list(func() error {
+			v, err := d.Value(typ.Elem)
+			list = append(list, v)
+			return err
+		})
+		return list, err
+	}
+
+	def := d.schema.Types[typ.NamedType]
+	if def != nil && def.Kind == ast.InputObject && d.peek() == '{' && d.decode != nil {
+		v, ok, err := d.decode(def.Name, d)
+		if ok {
+			in, _ := v.(*DecodedInput)
+			if in == nil {
+				return nil, err
+			}
+			if d.inputs == nil {
+				d.inputs = map[uintptr]*DecodedInput{}
+			}
+			d.inputs[mapKey(in.m)] = in
+			return in.m, err
+		}
+	}
+
+	return d.any()
+}
+
+// Object reads the next value, an object, calling member with each of its keys. member must read
+// the value of the key.
+func (d *InputDecoder) Object(member func(key string) error) error {
+	if err := d.expect('{'); err != nil {
+		return err
+	}
+	if d.peek() == '}' {
+		d.pos++
+		return nil
+	}
+	for {
+		key, err := d.string()
+		if err != nil {
+			return err
+		}
+		if err := d.expect(':'); err != nil {
+			return err
+		}
+		if err := member(key); err != nil {
+			return err
+		}
+
+		switch d.peek() {
+		case ',':
+			d.pos++
+		case '}':
+			d.pos++
+			return nil
+		default:
+			return d.syntaxError()
+		}
+	}
+}
+
+// NewInput starts reading an object of the input type name, fields are the names of its fields
+// in the order of the generated code.
+func (d *InputDecoder) NewInput(name string, fields []string) *DecodedInput {
+	return &DecodedInput{
+		def:    d.schema.Types[name],
+		fields: fields,
+		values: make([]decodedField, len(fields)),
+		m:      make(map[string]any, len(fields)),
+	}
+}
+
+func (d *InputDecoder) list(item func() error) error {
+	if err := d.expect('['); err != nil {
+		return err
+	}
+	if d.peek() == ']' {
+		d.pos++
+		return nil
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+
+		switch d.peek() {
+		case ',':
+			d.pos++
+		case ']':
+			d.pos++
+			return nil
+		default:
+			return d.syntaxError()
+		}
+	}
+}
+
+func (d *InputDecoder) syntaxError() error {
+	return gqlerror.Errorf("variables are not valid JSON, unexpected input at offset %d", d.pos)
+}
+
+// any reads the next value like encoding/json reads it into an any, with numbers kept as
+// json.Number.
+func (d *InputDecoder) any() (any, error) {
+	switch c := d.peek(); {
+	case c == '{':
+		obj := map[string]any{}
+		err := d.Object(func(key string) error {
+			v, err := d.any()
+			obj[key] = v
+			return err
+		})
+		return obj, err
+	case c == '[':
+		list := []any{}
+		err := d.list(func() error {
+			v, err := d.any()
+			list = append(list, v)
+			return err
+		})
+		return list, err
+	case c == '"':
+		return d.string()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return d.number()
+	case d.literal("true"):
+		return true, nil
+	case d.literal("false"):
+		return false, nil
+	case d.literal("null"):
+		return nil, nil
+	}
+	return nil, d.syntaxError()
+}
+
+func (d *InputDecoder) skip() error {
+	_, err := d.any()
+	return err
+}
+
+// peek skips whitespace and returns the next byte, or 0 at the end of the document.
+func (d *InputDecoder) peek() byte {
+	for d.pos < len(d.data) {
+		switch c := d.data[d.pos]; c {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+func (d *InputDecoder) expect(c byte) error {
+	if d.peek() != c {
+		return d.syntaxError()
+	}
+	d.pos++
+	return nil
+}
+
+func (d *InputDecoder) literal(s string) bool {
+	d.peek()
+	if !bytes.HasPrefix(d.data[d.pos:], []byte(s)) {
+		return false
+	}
+	d.pos += len(s)
+	return true
+}
+
+func (d *InputDecoder) string() (string, error) {
+	if err := d.expect('"'); err != nil {
+		return "", err
+	}
+	start := d.pos
+	escaped := false
+	for d.pos < len(d.data) {
+		switch c := d.data[d.pos]; {
+		case c == '\\':
+			escaped = true
+			d.pos += 2
+			continue
+		case c == '"':
+			d.pos++
+			if !escaped {
+				return string(d.data[start : d.pos-1]), nil
+			}
+			var s string
+			if err := json.Unmarshal(d.data[start-1:d.pos], &s); err != nil {
+				return "", d.syntaxError()
+			}
+			return s, nil
+		case c < ' ':
+			return "", d.syntaxError()
+		}
+		d.pos++
+	}
+	return "", d.syntaxError()
+}
+
+func (d *InputDecoder) number() (json.Number, error) {
+	d.peek()
+	start := d.pos
+	for d.pos < len(d.data) && strings.IndexByte("+-0123456789.eE", d.data[d.pos]) >= 0 {
+		d.pos++
+	}
+	n := d.data[start:d.pos]
+	if !json.Valid(n) {
+		return "", d.syntaxError()
+	}
+	return json.Number(n), nil
+}
+
+// DecodedInput is an input object read by the decoder generated for its type, together with the
+// map the variables hold for it. The generated unmarshalInput functions read its fields by their
+// position instead of looking them up in the map.
+type DecodedInput struct {
+	def    *ast.Definition
+	fields []string
+	values []decodedField
+	m      map[string]any
+}
+
+type decodedField struct {
+	value any
+	set   bool
+}
+
+// Decode reads the next value as the value of the field at position i.
+func (in *DecodedInput) Decode(d *InputDecoder, i int) error {
+	name := in.fields[i]
+	field := in.def.Fields.ForName(name)
+	if field == nil {
+		// the field is not part of the schema served, eg of a contract, the validator rejects it
+		return in.Unknown(d, name)
+	}
+	v, err := d.Value(field.Type)
+	in.values[i] = decodedField{value: v, set: true}
+	in.m[name] = v
+	return err
+}
+
+// Unknown reads the value of key, a key the generated code does not know, into the map only. The
+// validator rejects it unless it is __typename.
+func (in *DecodedInput) Unknown(d *InputDecoder, key string) error {
+	v, err := d.any()
+	in.m[key] = v
+	return err
+}
+
+// Complete sets the default values of the fields left out. They are not added to the map, like
+// the validator leaves them out of the variables.
+func (in *DecodedInput) Complete() {
+	for i, name := range in.fields {
+		field := in.def.Fields.ForName(name)
+		if in.values[i].set || field == nil || field.DefaultValue == nil {
+			continue
+		}
+		if v, err := field.DefaultValue.Value(nil); err == nil {
+			in.values[i] = decodedField{value: v, set: true}
+		}
+	}
+}
+
+// Field returns the value of the field at position i, and whether it was set, by the variables or
+// by its default value.
+func (in *DecodedInput) Field(i int) (any, bool) {
+	return in.values[i].value, in.values[i].set
+}
+
+// Fields returns the names of the fields given in the variables, in the order of the generated
+// code.
+func (in *DecodedInput) Fields() []string {
+	names := make([]string, 0, len(in.m))
+	for _, name := range in.fields {
+		if _, ok := in.m[name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Get returns the value of the field name, and whether it was given in the variables.
+func (in *DecodedInput) Get(name string) (any, bool) {
+	v, ok := in.m[name]
+	return v, ok
+}
+
+// Map returns the map the variables hold for the object.
+func (in *DecodedInput) Map() map[string]any {
+	return in.m
+}
@@ -0,0 +1,154 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+var decoderSchema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+	type Query { users(filter: Filter, ids: [ID!], limit: Int! = 10, ratio: Float, role: Role): Int }
+	enum Role { ADMIN USER }
+	input Filter {
+		name: String!
+		roles: [Role!]
+		nested: [Filter!]
+		active: Boolean! = true
+	}
+`})
+
+const decoderQuery = `query($filter: Filter, $ids: [ID!], $limit: Int! = 10, $ratio: Float, $role: Role) {
+	users(filter: $filter, ids: $ids, limit: $limit, ratio: $ratio, role: $role)
+}`
+
+func decodeFilter(name string, d *InputDecoder) (any, bool, error) {
+	if name != "Filter" {
+		return nil, false, nil
+	}
+	in := d.NewInput(name, []string{"name", "roles", "nested", "active"})
+	err := d.Object(func(k string) error {
+		switch k {
+		case "name":
+			return in.Decode(d, 0)
+		case "roles":
+			return in.Decode(d, 1)
+		case "nested":
+			return in.Decode(d, 2)
+		case "active":
+			return in.Decode(d, 3)
+		}
+		return in.Unknown(d, k)
+	})
+	if err != nil {
+		return nil, true, err
+	}
+	in.Complete()
+	return in, true, nil
+}
+
+func TestDecodeVariables(t *testing.T) {
+	op := gqlparser.MustLoadQuery(decoderSchema, decoderQuery).Operations[0]
+	ctx := WithOperationContext(context.Background(), &OperationContext{})
+
+	// validate decodes the variables like the executor does without decoders
+	validate := func(raw string) (map[string]any, error) {
+		var vars map[string]any
+		dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
+		dec.UseNumber()
+		require.NoError(t, dec.Decode(&vars))
+		return validator.VariableValues(decoderSchema, op, vars)
+	}
+
+	for _, tc := range []struct {
+		name string
+		raw  string
+		err  string
+	}{
+		{name: "empty", raw: `{}`},
+		{name: "scalars", raw: `{"ids": ["1", 2], "limit": 3, "ratio": 0.5, "role": "admin"}`},
+		{name: "input", raw: `{"filter": {"name": "a\"b", "roles": ["ADMIN"], "__typename": "Filter"}}`},
+		{name: "nested input", raw: `{"filter": {"name": "a", "nested": [{"name": "b", "active": false}]}}`},
+		{name: "null", raw: `{"filter": null, "ids": [], "ratio": null}`},
+		{name: "null limit", raw: `{"limit": null}`, err: "input: variable.limit cannot be null"},
+		{name: "float limit", raw: `{"limit": 1.5}`, err: "input: variable.limit cannot use value 0 as Int"},
+		{name: "wrong scalar", raw: `{"ids": [true]}`, err: "input: variable.ids[0] cannot use bool as ID"},
+		{name: "wrong enum", raw: `{"role": "OWNER"}`, err: "input: variable.role OWNER is not a valid Role"},
+		{name: "missing field", raw: `{"filter": {"roles": []}}`, err: "input: variable.filter.name must be defined"},
+		{name: "null field", raw: `{"filter": {"name": null}}`, err: "input: variable.filter.name cannot be null"},
+		{name: "unknown field", raw: `{"filter": {"name": "a", "age": 3}}`, err: "input: variable.filter.age unknown field"},
+		{name: "not an object", raw: `{"filter": "a"}`, err: "input: variable.filter must be a Filter, not a string"},
+		{name: "nested error", raw: `{"filter": {"name": "a", "nested": [{"name": "b"}, {"name": "c", "roles": ["ADMIN", null]}]}}`, err: "input: variable.filter.nested[1].roles[1] cannot be null"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want, wantErr := validate(tc.raw)
+			if tc.err != "" {
+				require.EqualError(t, wantErr, tc.err)
+			}
+
+			for _, decode := range []InputDecodeFunc{nil, decodeFilter} {
+				got, err := DecodeVariables(ctx, decoderSchema, op, json.RawMessage(tc.raw), decode)
+				if tc.err != "" {
+					require.EqualError(t, err, tc.err)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, want, got)
+				}
+			}
+		})
+	}
+
+	t.Run("decoded input", func(t *testing.T) {
+		ctx := WithOperationContext(context.Background(), &OperationContext{})
+		vars, err := DecodeVariables(ctx, decoderSchema, op, json.RawMessage(`{"filter": {"nested": {"name": "b"}, "name": "a"}}`), decodeFilter)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"filter": map[string]any{"name": "a", "nested": []map[string]any{{"name": "b"}}},
+			"limit":  int64(10),
+		}, vars)
+		filter := DecodedInputFor(ctx, vars["filter"])
+		require.NotNil(t, filter)
+
+		name, ok := filter.Field(0)
+		require.True(t, ok)
+		require.Equal(t, "a", name)
+		_, ok = filter.Field(1)
+		require.False(t, ok)
+		active, ok := filter.Field(3)
+		require.True(t, ok)
+		require.Equal(t, true, active)
+
+		require.Equal(t, []string{"name", "nested"}, filter.Fields())
+		_, ok = filter.Get("active")
+		require.False(t, ok, "default values are not given in the variables")
+		require.Equal(t, vars["filter"], filter.Map())
+
+		nested := DecodedInputFor(ctx, vars["filter"].(map[string]any)["nested"].([]map[string]any)[0])
+		require.NotNil(t, nested)
+		name, _ = nested.Field(0)
+		require.Equal(t, "b", name)
+
+		require.Nil(t, DecodedInputFor(ctx, map[string]any{"name": "a"}))
+		require.Nil(t, DecodedInputFor(context.Background(), vars["filter"]))
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		for _, raw := range []string{`{"filter": {"name": "a"}`, `{"ids": [1 2]}`, `{"limit": 1} x`, `{"ratio": -}`} {
+			_, err := DecodeVariables(ctx, decoderSchema, op, json.RawMessage(raw), decodeFilter)
+			require.ErrorContains(t, err, "variables are not valid JSON", raw)
+		}
+	})
+
+	t.Run("no variables", func(t *testing.T) {
+		for _, raw := range []string{``, `null`} {
+			vars, err := DecodeVariables(ctx, decoderSchema, op, json.RawMessage(raw), decodeFilter)
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{"limit": int64(10)}, vars)
+		}
+	})
+}
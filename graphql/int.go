@@ -11,7 +11,7 @@ import (
 
 func MarshalInt(i int) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatInt(int64(i), 10))
+		writeInt(w, int64(i))
 	})
 }
 
@@ -21,7 +21,7 @@ func UnmarshalInt(v any) (int, error) {
 
 func MarshalInt8(i int8) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatInt(int64(i), 10))
+		writeInt(w, int64(i))
 	})
 }
 
@@ -31,7 +31,7 @@ func UnmarshalInt8(v any) (int8, error) {
 
 func MarshalInt16(i int16) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatInt(int64(i), 10))
+		writeInt(w, int64(i))
 	})
 }
 
@@ -41,7 +41,7 @@ func UnmarshalInt16(v any) (int16, error) {
 
 func MarshalInt32(i int32) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatInt(int64(i), 10))
+		writeInt(w, int64(i))
 	})
 }
 
@@ -51,7 +51,7 @@ func UnmarshalInt32(v any) (int32, error) {
 
 func MarshalInt64(i int64) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatInt(i, 10))
+		writeInt(w, i)
 	})
 }
 
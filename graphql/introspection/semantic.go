@@ -0,0 +1,107 @@
+package introspection
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var semanticNonNullSchemas sync.Map
+
+// SemanticNonNullSchema returns a copy of schema where the types of the fields marked with
+// @semanticNonNull are non-null at their levels. Clients of operations disabling error
+// propagation introspect it, nulls never bubble up for them so these fields are only null on
+// errors. The schema itself is returned when it has no such fields.
+func SemanticNonNullSchema(schema *ast.Schema) *ast.Schema {
+	if cached, ok := semanticNonNullSchemas.Load(schema); ok {
+		return cached.(*ast.Schema)
+	}
+
+	copies := map[*ast.Definition]*ast.Definition{}
+	for _, def := range schema.Types {
+		var fields ast.FieldList
+		for i, field := range def.Fields {
+			d := field.Directives.ForName("semanticNonNull")
+			if d == nil {
+				continue
+			}
+			if fields == nil {
+				fields = slices.Clone(def.Fields)
+			}
+			semantic := *field
+			semantic.Type = semanticNonNullType(field.Type, semanticNonNullLevels(d), 0)
+			fields[i] = &semantic
+		}
+		if fields != nil {
+			semantic := *def
+			semantic.Fields = fields
+			copies[def] = &semantic
+		}
+	}
+
+	ret := schema
+	if len(copies) > 0 {
+		ret = copySchema(schema, copies)
+	}
+	cached, _ := semanticNonNullSchemas.LoadOrStore(schema, ret)
+	return cached.(*ast.Schema)
+}
+
+func semanticNonNullLevels(d *ast.Directive) []int {
+	value, ok := d.ArgumentMap(nil)["levels"]
+	if !ok {
+		return []int{0}
+	}
+	values, _ := value.([]any)
+	levels := make([]int, 0, len(values))
+	for _, v := range values {
+		if n, ok := v.(int64); ok {
+			levels = append(levels, int(n))
+		}
+	}
+	return levels
+}
+
+func semanticNonNullType(t *ast.Type, levels []int, level int) *ast.Type {
+	ret := *t
+	if t.Elem != nil {
+		ret.Elem = semanticNonNullType(t.Elem, levels, level+1)
+	}
+	if slices.Contains(levels, level) {
+		ret.NonNull = true
+	}
+	return &ret
+}
+
+// copySchema returns a copy of schema using the copies of its definitions.
+func copySchema(schema *ast.Schema, copies map[*ast.Definition]*ast.Definition) *ast.Schema {
+	replace := func(def *ast.Definition) *ast.Definition {
+		if c, ok := copies[def]; ok {
+			return c
+		}
+		return def
+	}
+	replaceAll := func(defs map[string][]*ast.Definition) map[string][]*ast.Definition {
+		ret := make(map[string][]*ast.Definition, len(defs))
+		for name, list := range defs {
+			ret[name] = make([]*ast.Definition, len(list))
+			for i, def := range list {
+				ret[name][i] = replace(def)
+			}
+		}
+		return ret
+	}
+
+	ret := *schema
+	ret.Query = replace(schema.Query)
+	ret.Mutation = replace(schema.Mutation)
+	ret.Subscription = replace(schema.Subscription)
+	ret.Types = make(map[string]*ast.Definition, len(schema.Types))
+	for name, def := range schema.Types {
+		ret.Types[name] = replace(def)
+	}
+	ret.PossibleTypes = replaceAll(schema.PossibleTypes)
+	ret.Implements = replaceAll(schema.Implements)
+	return &ret
+}
@@ -0,0 +1,43 @@
+package introspection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestSemanticNonNullSchema(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @semanticNonNull(levels: [Int] = [0]) on FIELD_DEFINITION
+
+		interface Node { id: ID! }
+		type Query { user: User @semanticNonNull, node: Node }
+		type User implements Node {
+			id: ID!
+			name: String @semanticNonNull
+			friends: [User] @semanticNonNull(levels: [1])
+			email: String
+		}
+	`})
+
+	semantic := SemanticNonNullSchema(schema)
+	require.NotSame(t, schema, semantic)
+	require.Same(t, semantic, SemanticNonNullSchema(schema))
+
+	user := semantic.Types["User"]
+	require.Equal(t, "String!", user.Fields.ForName("name").Type.String())
+	require.Equal(t, "[User!]", user.Fields.ForName("friends").Type.String())
+	require.Equal(t, "String", user.Fields.ForName("email").Type.String())
+	require.Equal(t, "User!", semantic.Query.Fields.ForName("user").Type.String())
+	require.Same(t, semantic.Types["Query"], semantic.Query)
+	require.Same(t, user, semantic.PossibleTypes["Node"][0])
+	require.Same(t, semantic.Types["Node"], semantic.Implements["User"][0])
+
+	require.Equal(t, "String", schema.Types["User"].Fields.ForName("name").Type.String())
+	require.Equal(t, "User", schema.Query.Fields.ForName("user").Type.String())
+
+	plain := gqlparser.MustLoadSchema(&ast.Source{Input: `type Query { name: String }`})
+	require.Same(t, plain, SemanticNonNullSchema(plain))
+}
@@ -3,6 +3,7 @@ package graphql
 import (
 	"context"
 	"io"
+	"strconv"
 )
 
 var (
@@ -91,3 +92,35 @@ func (l lit) MarshalGQLContext(ctx context.Context, w io.Writer) error {
 	w.Write(l.b)
 	return nil
 }
+
+// bufferedWriter is implemented by writers like bytes.Buffer and bufio.Writer, scalars format their
+// values straight into the free space of their buffer.
+type bufferedWriter interface {
+	io.Writer
+	AvailableBuffer() []byte
+}
+
+func writeInt(w io.Writer, i int64) {
+	if bw, ok := w.(bufferedWriter); ok {
+		_, _ = bw.Write(strconv.AppendInt(bw.AvailableBuffer(), i, 10))
+		return
+	}
+	_, _ = io.WriteString(w, strconv.FormatInt(i, 10))
+}
+
+func writeUint(w io.Writer, i uint64) {
+	if bw, ok := w.(bufferedWriter); ok {
+		_, _ = bw.Write(strconv.AppendUint(bw.AvailableBuffer(), i, 10))
+		return
+	}
+	_, _ = io.WriteString(w, strconv.FormatUint(i, 10))
+}
+
+// writeFloat writes f like the %g verb of fmt formats it.
+func writeFloat(w io.Writer, f float64) {
+	if bw, ok := w.(bufferedWriter); ok {
+		_, _ = bw.Write(strconv.AppendFloat(bw.AvailableBuffer(), f, 'g', -1, 64))
+		return
+	}
+	_, _ = io.WriteString(w, strconv.FormatFloat(f, 'g', -1, 64))
+}
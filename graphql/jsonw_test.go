@@ -1,7 +1,11 @@
 package graphql
 
 import (
+	"bufio"
 	"bytes"
+	"fmt"
+	"io"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -50,3 +54,34 @@ func TestJsonWriter(t *testing.T) {
 		b.String(),
 	)
 }
+
+func TestScalarWriters(t *testing.T) {
+	values := map[string]Marshaler{
+		"-42":                          MarshalInt(-42),
+		"9223372036854775807":          MarshalInt64(math.MaxInt64),
+		"18446744073709551615":         MarshalUint64(math.MaxUint64),
+		`"12"`:                         MarshalIntID(12),
+		`"7"`:                          MarshalUintID(7),
+		"1.5e-07":                      MarshalFloat(1.5e-7),
+		fmt.Sprintf("%g", math.Inf(1)): MarshalFloat(math.Inf(1)),
+	}
+
+	for want, m := range values {
+		var buf bytes.Buffer
+		m.MarshalGQL(&buf)
+		require.Equal(t, want, buf.String())
+
+		// writers without a buffer of their own get the same output
+		buf.Reset()
+		m.MarshalGQL(struct{ io.Writer }{&buf})
+		require.Equal(t, want, buf.String())
+	}
+
+	t.Run("buffered writers are written without allocating", func(t *testing.T) {
+		w := bufio.NewWriter(io.Discard)
+		for want, m := range values {
+			allocs := testing.AllocsPerRun(10, func() { m.MarshalGQL(w) })
+			require.Zero(t, allocs, want)
+		}
+	})
+}
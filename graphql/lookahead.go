@@ -0,0 +1,176 @@
+package graphql
+
+import (
+	"context"
+	"slices"
+)
+
+// GoFieldMapper is implemented by the generated executable schemas, it maps the fields of object
+// types to the Go struct fields of their models.
+type GoFieldMapper interface {
+	// GoFieldName returns the name of the Go struct field the field of typeName is bound to,
+	// false when the field is resolved by a resolver or a method.
+	GoFieldName(typeName, fieldName string) (string, bool)
+}
+
+// Selection is a field selected in an operation, with the fields selected on its value.
+type Selection struct {
+	// Alias is the name of the field in the response, its Name when it has no alias.
+	Alias string
+	// Name is the name of the field in the schema.
+	Name string
+	// ObjectType is the object type the field is selected on.
+	ObjectType string
+	// Arguments are the arguments of the field, with their variables resolved and their
+	// default values applied.
+	Arguments map[string]any
+	// GoField is the name of the Go struct field the field is bound to in the model of
+	// ObjectType, empty when it is resolved by a resolver or a method, or when the executable
+	// schema does not map fields.
+	GoField string
+	// Field is the field collected from the operation.
+	Field CollectedField
+	// Selections are the fields selected on the value of the field, by the object types it can
+	// have: its type when it is an object type, and every possible type of an interface or a
+	// union, with the type conditions of fragments resolved. It is nil for leaf fields.
+	Selections map[string][]*Selection
+}
+
+// Lookahead returns the selection of the field being resolved in ctx, to find out which fields
+// of its value the operation reads before fetching them, like the columns of a SQL query.
+func Lookahead(ctx context.Context) *Selection {
+	fc := GetFieldContext(ctx)
+	return newSelection(GetOperationContext(ctx), fc.Object, fc.Field)
+}
+
+func newSelection(opCtx *OperationContext, objectType string, field CollectedField) *Selection {
+	s := &Selection{
+		Alias:      field.Alias,
+		Name:       field.Name,
+		ObjectType: objectType,
+		Field:      field,
+	}
+	if field.Definition != nil {
+		s.Arguments = field.ArgumentMap(opCtx.Variables)
+	}
+	if s.Alias == "" {
+		s.Alias = s.Name
+	}
+	if mapper, ok := opCtx.ExecutableSchema.(GoFieldMapper); ok {
+		s.GoField, _ = mapper.GoFieldName(objectType, field.Name)
+	}
+	if len(field.Selections) == 0 || field.Definition == nil {
+		return s
+	}
+
+	s.Selections = map[string][]*Selection{}
+	for typeName, satisfies := range possibleTypes(opCtx, field.Definition.Type.Name()) {
+		collected := CollectFields(opCtx, field.Selections, satisfies)
+		children := make([]*Selection, 0, len(collected))
+		for _, child := range collected {
+			children = append(children, newSelection(opCtx, typeName, child))
+		}
+		s.Selections[typeName] = children
+	}
+	return s
+}
+
+// possibleTypes returns the object types a value of typeName can have, with the types each of
+// them satisfies in type conditions. Without a schema, typeName is returned satisfying every
+// type condition.
+func possibleTypes(opCtx *OperationContext, typeName string) map[string][]string {
+	if opCtx.ExecutableSchema == nil {
+		return map[string][]string{typeName: nil}
+	}
+	schema := opCtx.ExecutableSchema.Schema()
+	def := schema.Types[typeName]
+	if def == nil {
+		return map[string][]string{typeName: nil}
+	}
+
+	types := map[string][]string{}
+	for _, possible := range schema.GetPossibleTypes(def) {
+		satisfies := []string{possible.Name}
+		for _, implements := range schema.GetImplements(possible) {
+			satisfies = append(satisfies, implements.Name)
+		}
+		types[possible.Name] = satisfies
+	}
+	return types
+}
+
+// Fields returns the fields selected on the value of the field when it has the object type
+// typeName. When typeName is empty, it returns the fields selected for any of its types, each
+// alias once.
+func (s *Selection) Fields(typeName string) []*Selection {
+	if typeName != "" {
+		return s.Selections[typeName]
+	}
+	if len(s.Selections) == 1 {
+		for _, fields := range s.Selections {
+			return fields
+		}
+	}
+
+	var fields []*Selection
+	for _, typeName := range s.typeNames() {
+		for _, field := range s.Selections[typeName] {
+			if !slices.ContainsFunc(fields, func(f *Selection) bool { return f.Alias == field.Alias }) {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
+// Has reports whether the fields of path, names of fields in the schema, are selected below the
+// field for any of its types.
+func (s *Selection) Has(path ...string) bool {
+	if len(path) == 0 {
+		return true
+	}
+	for _, fields := range s.Selections {
+		for _, field := range fields {
+			if field.Name == path[0] && field.Has(path[1:]...) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GoFieldPaths returns the paths of the Go struct fields the fields selected below the field are
+// bound to, like "Name" or "Address.City", sorted. Fields resolved by a resolver or a method and
+// the fields below them are left out, they are not read from the model of the field.
+func (s *Selection) GoFieldPaths() []string {
+	var paths []string
+	s.appendGoFieldPaths(&paths, "")
+	slices.Sort(paths)
+	return slices.Compact(paths)
+}
+
+func (s *Selection) appendGoFieldPaths(paths *[]string, prefix string) {
+	for _, fields := range s.Selections {
+		for _, field := range fields {
+			if field.GoField == "" {
+				continue
+			}
+			path := prefix + field.GoField
+			n := len(*paths)
+			field.appendGoFieldPaths(paths, path+".")
+			if len(*paths) == n {
+				// a leaf field, or a field none of whose selected fields are Go struct fields
+				*paths = append(*paths, path)
+			}
+		}
+	}
+}
+
+func (s *Selection) typeNames() []string {
+	names := make([]string, 0, len(s.Selections))
+	for name := range s.Selections {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestLookahead(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { node(id: ID!): Node }
+		interface Node { id: ID! }
+		type User implements Node { id: ID! name: String! }
+		type Post implements Node { id: ID! title: String! }
+	`})
+	doc := gqlparser.MustLoadQuery(schema, `
+		query($id: ID!) {
+			node(id: $id) {
+				id
+				... on User { name }
+				...Post
+			}
+		}
+		fragment Post on Post { title }
+	`)
+	op := doc.Operations[0]
+	lookahead := func(es ExecutableSchema) *Selection {
+		opCtx := &OperationContext{
+			Doc:              doc,
+			Operation:        op,
+			Variables:        map[string]any{"id": "1"},
+			ExecutableSchema: es,
+		}
+		field := CollectFields(opCtx, op.SelectionSet, nil)[0]
+		ctx := WithOperationContext(context.Background(), opCtx)
+		ctx = WithFieldContext(ctx, &FieldContext{Object: "Query", Field: field})
+		return Lookahead(ctx)
+	}
+	names := func(fields []*Selection) []string {
+		var names []string
+		for _, f := range fields {
+			names = append(names, f.Name)
+		}
+		return names
+	}
+
+	t.Run("type conditions are resolved by possible type", func(t *testing.T) {
+		sel := lookahead(&ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return schema }})
+
+		require.Equal(t, map[string]any{"id": "1"}, sel.Arguments)
+		require.Len(t, sel.Selections, 2)
+		require.Equal(t, []string{"id", "name"}, names(sel.Fields("User")))
+		require.Equal(t, []string{"id", "title"}, names(sel.Fields("Post")))
+		require.Equal(t, []string{"id", "title", "name"}, names(sel.Fields("")))
+		require.Equal(t, "Post", sel.Fields("Post")[1].ObjectType)
+		require.Empty(t, sel.GoFieldPaths())
+	})
+
+	t.Run("without executable schema", func(t *testing.T) {
+		sel := lookahead(nil)
+
+		require.Equal(t, []string{"id", "name", "title"}, names(sel.Fields("Node")))
+	})
+}
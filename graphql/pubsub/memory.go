@@ -0,0 +1,195 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Memory is an in-process Broker. Every subscriber gets its own buffer, so a slow subscriber only
+// affects other subscribers when it uses the Block drop policy.
+type Memory struct {
+	mu     sync.RWMutex
+	subs   map[*memorySubscription]struct{}
+	closed bool
+}
+
+var _ Broker = &Memory{}
+
+// NewMemory returns a ready to use in-memory broker.
+func NewMemory() *Memory {
+	return &Memory{
+		subs: map[*memorySubscription]struct{}{},
+	}
+}
+
+// Publish delivers payload to every matching subscriber. It only returns an error when the
+// broker is closed or, for subscribers using the Block policy, when ctx is done before the
+// message could be buffered.
+func (m *Memory) Publish(ctx context.Context, topic string, payload any) error {
+	m.mu.RLock()
+	if m.closed {
+		m.mu.RUnlock()
+		return ErrClosed
+	}
+	var targets []*memorySubscription
+	for sub := range m.subs {
+		if Match(sub.pattern, topic) {
+			targets = append(targets, sub)
+		}
+	}
+	m.mu.RUnlock()
+
+	msg := Message{Topic: topic, Payload: payload}
+	for _, sub := range targets {
+		if err := sub.deliver(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a subscriber for pattern. The subscription ends when ctx is cancelled.
+// The returned Subscription also has a Dropped() uint64 method reporting how many messages were
+// discarded by the drop policy.
+func (m *Memory) Subscribe(
+	ctx context.Context,
+	pattern string,
+	opts ...SubscribeOption,
+) (Subscription, error) {
+	o := NewSubscribeOptions(opts...)
+	sub := &memorySubscription{
+		broker:  m,
+		pattern: pattern,
+		policy:  o.DropPolicy,
+		ch:      make(chan Message, o.BufferSize),
+		done:    make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, ErrClosed
+	}
+	m.subs[sub] = struct{}{}
+	sub.stop = context.AfterFunc(ctx, sub.Unsubscribe)
+	m.mu.Unlock()
+
+	return sub, nil
+}
+
+// Subscribers returns the number of active subscriptions.
+func (m *Memory) Subscribers() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.subs)
+}
+
+// Close ends all subscriptions. Subsequent calls to Publish and Subscribe return ErrClosed.
+func (m *Memory) Close() error {
+	m.mu.Lock()
+	m.closed = true
+	subs := m.subs
+	m.subs = map[*memorySubscription]struct{}{}
+	m.mu.Unlock()
+
+	for sub := range subs {
+		sub.close()
+	}
+	return nil
+}
+
+func (m *Memory) remove(sub *memorySubscription) {
+	m.mu.Lock()
+	delete(m.subs, sub)
+	m.mu.Unlock()
+}
+
+type memorySubscription struct {
+	broker  *Memory
+	pattern string
+	policy  DropPolicy
+
+	// mu is held for reading while delivering and for writing while closing ch, so nothing is
+	// ever sent on a closed channel.
+	mu      sync.RWMutex
+	ch      chan Message
+	done    chan struct{}
+	once    sync.Once
+	stop    func() bool
+	closed  bool
+	dropped atomic.Uint64
+}
+
+func (s *memorySubscription) Messages() <-chan Message {
+	return s.ch
+}
+
+func (s *memorySubscription) Unsubscribe() {
+	s.broker.remove(s)
+	s.close()
+}
+
+// Dropped returns the number of messages discarded because the buffer was full.
+func (s *memorySubscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+func (s *memorySubscription) close() {
+	s.once.Do(func() {
+		if s.stop != nil {
+			s.stop()
+		}
+		// closing done first releases any publisher blocked in deliver, which in turn releases
+		// the read lock we need to take below.
+		close(s.done)
+		s.mu.Lock()
+		s.closed = true
+		close(s.ch)
+		s.mu.Unlock()
+	})
+}
+
+func (s *memorySubscription) deliver(ctx context.Context, msg Message) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return nil
+	}
+
+	switch s.policy {
+	case DropNewest:
+		select {
+		case s.ch <- msg:
+		default:
+			s.dropped.Add(1)
+		}
+	case Block:
+		select {
+		case s.ch <- msg:
+		case <-s.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	default:
+		for {
+			select {
+			case s.ch <- msg:
+				return nil
+			default:
+			}
+			select {
+			case <-s.ch:
+				s.dropped.Add(1)
+			default:
+			}
+			if cap(s.ch) == 0 {
+				// an unbuffered subscriber that is not currently receiving can never be
+				// made room for.
+				s.dropped.Add(1)
+				return nil
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package pubsub_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/pubsub"
+)
+
+func receive(t *testing.T, ch <-chan pubsub.Message) pubsub.Message {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		require.True(t, ok, "channel closed")
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return pubsub.Message{}
+	}
+}
+
+func TestMemory(t *testing.T) {
+	t.Run("fan out to matching subscribers", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		ctx := context.Background()
+
+		lobby, err := b.Subscribe(ctx, "chat.lobby")
+		require.NoError(t, err)
+		all, err := b.Subscribe(ctx, "chat.*")
+		require.NoError(t, err)
+		other, err := b.Subscribe(ctx, "news.>")
+		require.NoError(t, err)
+
+		require.NoError(t, b.Publish(ctx, "chat.lobby", "hello"))
+		require.NoError(t, b.Publish(ctx, "chat.kitchen", "food"))
+
+		assert.Equal(t, pubsub.Message{Topic: "chat.lobby", Payload: "hello"}, receive(t, lobby.Messages()))
+		assert.Equal(t, "hello", receive(t, all.Messages()).Payload)
+		assert.Equal(t, "food", receive(t, all.Messages()).Payload)
+		assert.Empty(t, lobby.Messages())
+		assert.Empty(t, other.Messages())
+	})
+
+	t.Run("context cancel unsubscribes", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		sub, err := b.Subscribe(ctx, "topic")
+		require.NoError(t, err)
+		require.Equal(t, 1, b.Subscribers())
+
+		cancel()
+		select {
+		case _, ok := <-sub.Messages():
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+		require.Equal(t, 0, b.Subscribers())
+		require.NoError(t, b.Publish(context.Background(), "topic", 1))
+	})
+
+	t.Run("unsubscribe is idempotent", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		sub, err := b.Subscribe(context.Background(), "topic")
+		require.NoError(t, err)
+
+		sub.Unsubscribe()
+		sub.Unsubscribe()
+		require.Equal(t, 0, b.Subscribers())
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		ctx := context.Background()
+		sub, err := b.Subscribe(ctx, "topic", pubsub.WithBuffer(2), pubsub.WithDropPolicy(pubsub.DropOldest))
+		require.NoError(t, err)
+
+		for i := 1; i <= 4; i++ {
+			require.NoError(t, b.Publish(ctx, "topic", i))
+		}
+
+		assert.Equal(t, 3, receive(t, sub.Messages()).Payload)
+		assert.Equal(t, 4, receive(t, sub.Messages()).Payload)
+		assert.Equal(t, uint64(2), sub.(interface{ Dropped() uint64 }).Dropped())
+	})
+
+	t.Run("drop newest", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		ctx := context.Background()
+		sub, err := b.Subscribe(ctx, "topic", pubsub.WithBuffer(2), pubsub.WithDropPolicy(pubsub.DropNewest))
+		require.NoError(t, err)
+
+		for i := 1; i <= 4; i++ {
+			require.NoError(t, b.Publish(ctx, "topic", i))
+		}
+
+		assert.Equal(t, 1, receive(t, sub.Messages()).Payload)
+		assert.Equal(t, 2, receive(t, sub.Messages()).Payload)
+		assert.Equal(t, uint64(2), sub.(interface{ Dropped() uint64 }).Dropped())
+	})
+
+	t.Run("block waits for room", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		sub, err := b.Subscribe(context.Background(), "topic", pubsub.WithBuffer(1), pubsub.WithDropPolicy(pubsub.Block))
+		require.NoError(t, err)
+
+		require.NoError(t, b.Publish(context.Background(), "topic", 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, b.Publish(ctx, "topic", 2), context.DeadlineExceeded)
+
+		done := make(chan error)
+		go func() {
+			done <- b.Publish(context.Background(), "topic", 3)
+		}()
+		assert.Equal(t, 1, receive(t, sub.Messages()).Payload)
+		require.NoError(t, <-done)
+		assert.Equal(t, 3, receive(t, sub.Messages()).Payload)
+	})
+
+	t.Run("unsubscribe releases blocked publisher", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		sub, err := b.Subscribe(context.Background(), "topic", pubsub.WithBuffer(0), pubsub.WithDropPolicy(pubsub.Block))
+		require.NoError(t, err)
+
+		done := make(chan error)
+		go func() {
+			done <- b.Publish(context.Background(), "topic", 1)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		sub.Unsubscribe()
+
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("publisher still blocked")
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		sub, err := b.Subscribe(context.Background(), "topic")
+		require.NoError(t, err)
+
+		require.NoError(t, b.Close())
+		_, ok := <-sub.Messages()
+		require.False(t, ok)
+
+		require.ErrorIs(t, b.Publish(context.Background(), "topic", 1), pubsub.ErrClosed)
+		_, err = b.Subscribe(context.Background(), "topic")
+		require.ErrorIs(t, err, pubsub.ErrClosed)
+	})
+
+	t.Run("concurrent publish and unsubscribe", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			ctx, cancel := context.WithCancel(context.Background())
+			_, err := b.Subscribe(ctx, "topic", pubsub.WithBuffer(1))
+			require.NoError(t, err)
+
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_ = b.Publish(context.Background(), "topic", j)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				cancel()
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,237 @@
+// Package pubsub provides a small publish/subscribe abstraction for feeding subscription
+// resolvers. Resolvers talk to a Broker, so an in-memory broker used during development can be
+// swapped for one backed by NATS, Redis or similar without touching the resolvers themselves.
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrClosed is returned when publishing to or subscribing on a broker that has been closed.
+var ErrClosed = errors.New("pubsub: broker closed")
+
+// Message is a single event delivered to a subscriber.
+type Message struct {
+	// Topic is the concrete topic the message was published on, never a pattern.
+	Topic string
+	// Payload is the published value. In-memory brokers pass it through untouched, brokers that
+	// cross a process boundary will usually deliver []byte or json.RawMessage.
+	Payload any
+}
+
+// Subscription is a live subscription returned by Broker.Subscribe.
+type Subscription interface {
+	// Messages returns the channel messages are delivered on. It is closed once the subscription
+	// ends, either because Unsubscribe was called, the subscribe context was cancelled or the
+	// broker was closed.
+	Messages() <-chan Message
+	// Unsubscribe stops delivery and closes the Messages channel. It is safe to call more than
+	// once and from multiple goroutines.
+	Unsubscribe()
+}
+
+// Broker fans published messages out to every subscription whose pattern matches the topic.
+//
+// Implementations must end a subscription when the context passed to Subscribe is cancelled, so
+// a subscription resolver can simply hand its own context over and forget about cleanup.
+type Broker interface {
+	// Publish sends payload to all subscribers whose pattern matches topic.
+	Publish(ctx context.Context, topic string, payload any) error
+	// Subscribe registers interest in all topics matching pattern, see Match for the syntax.
+	Subscribe(ctx context.Context, pattern string, opts ...SubscribeOption) (Subscription, error)
+}
+
+// DropPolicy decides what happens when a subscriber's buffer is full.
+type DropPolicy int
+
+const (
+	// DropOldest discards the oldest buffered message to make room for the new one. Slow
+	// subscribers always see the most recent events.
+	DropOldest DropPolicy = iota
+	// DropNewest discards the message being published, keeping what is already buffered.
+	DropNewest
+	// Block makes Publish wait until the subscriber has room, the subscription ends or the
+	// publish context is done. A single slow subscriber will slow down every publisher.
+	Block
+)
+
+func (p DropPolicy) String() string {
+	switch p {
+	case DropOldest:
+		return "DropOldest"
+	case DropNewest:
+		return "DropNewest"
+	case Block:
+		return "Block"
+	default:
+		return "DropPolicy(unknown)"
+	}
+}
+
+// DefaultBufferSize is the per subscriber buffer used when WithBuffer is not given.
+const DefaultBufferSize = 16
+
+// SubscribeOptions holds the per subscription settings. Broker implementations obtain them with
+// NewSubscribeOptions.
+type SubscribeOptions struct {
+	BufferSize int
+	DropPolicy DropPolicy
+}
+
+// SubscribeOption configures a single subscription.
+type SubscribeOption func(o *SubscribeOptions)
+
+// WithBuffer sets how many undelivered messages are held for the subscriber.
+func WithBuffer(size int) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.BufferSize = size
+	}
+}
+
+// WithDropPolicy sets what happens to messages once the subscriber's buffer is full.
+func WithDropPolicy(policy DropPolicy) SubscribeOption {
+	return func(o *SubscribeOptions) {
+		o.DropPolicy = policy
+	}
+}
+
+// NewSubscribeOptions applies opts on top of the defaults.
+func NewSubscribeOptions(opts ...SubscribeOption) SubscribeOptions {
+	o := SubscribeOptions{
+		BufferSize: DefaultBufferSize,
+		DropPolicy: DropOldest,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.BufferSize < 0 {
+		o.BufferSize = 0
+	}
+	return o
+}
+
+// Match reports whether topic matches pattern. Topics and patterns are made of dot separated
+// tokens, in a pattern "*" matches exactly one token and a trailing ">" matches one or more
+// tokens. This is the same syntax NATS uses for subjects, which keeps patterns portable.
+//
+//	Match("chat.*", "chat.lobby")         // true
+//	Match("chat.*", "chat.lobby.typing")  // false
+//	Match("chat.>", "chat.lobby.typing")  // true
+func Match(pattern, topic string) bool {
+	if pattern == topic {
+		return true
+	}
+
+	p := strings.Split(pattern, ".")
+	t := strings.Split(topic, ".")
+	for i, token := range p {
+		if token == ">" && i == len(p)-1 {
+			return len(t) > i
+		}
+		if i >= len(t) {
+			return false
+		}
+		if token != "*" && token != t[i] {
+			return false
+		}
+	}
+	return len(p) == len(t)
+}
+
+// Subscribe subscribes to pattern on b and converts each message payload to T, returning a
+// channel in the shape generated subscription resolvers expect:
+//
+//	func (r *subscriptionResolver) MessageAdded(ctx context.Context, room string) (<-chan *Message, error) {
+//		return pubsub.Subscribe[*Message](ctx, r.Broker, "room."+room)
+//	}
+//
+// Payloads that already have type T are passed through, []byte and json.RawMessage payloads are
+// decoded as JSON. Messages that can not be converted are skipped. The returned channel is closed
+// when ctx is cancelled or the subscription ends.
+func Subscribe[T any](
+	ctx context.Context,
+	b Broker,
+	pattern string,
+	opts ...SubscribeOption,
+) (<-chan T, error) {
+	return SubscribeFunc(ctx, b, pattern, Decode[T], opts...)
+}
+
+// SubscribeFunc works like Subscribe but converts messages using decode. Messages for which
+// decode returns an error are skipped.
+func SubscribeFunc[T any](
+	ctx context.Context,
+	b Broker,
+	pattern string,
+	decode func(msg Message) (T, error),
+	opts ...SubscribeOption,
+) (<-chan T, error) {
+	sub, err := b.Subscribe(ctx, pattern, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return Chan(ctx, sub, decode), nil
+}
+
+// Chan forwards the messages of sub to a typed channel using decode. It unsubscribes and closes
+// the returned channel when ctx is done or sub's channel is closed.
+func Chan[T any](ctx context.Context, sub Subscription, decode func(msg Message) (T, error)) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-sub.Messages():
+				if !ok {
+					return
+				}
+				v, err := decode(msg)
+				if err != nil {
+					continue
+				}
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// Decode converts a message payload to T. It is the decoder used by Subscribe.
+func Decode[T any](msg Message) (T, error) {
+	var v T
+	switch payload := msg.Payload.(type) {
+	case T:
+		return payload, nil
+	case json.RawMessage:
+		err := json.Unmarshal(payload, &v)
+		return v, err
+	case []byte:
+		err := json.Unmarshal(payload, &v)
+		return v, err
+	default:
+		return v, &TypeError{Topic: msg.Topic, Payload: msg.Payload, Want: v}
+	}
+}
+
+// TypeError is returned by Decode when a payload can not be converted to the wanted type.
+type TypeError struct {
+	Topic   string
+	Payload any
+	Want    any
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("pubsub: payload on %s has type %T, want %T", e.Topic, e.Payload, e.Want)
+}
@@ -0,0 +1,164 @@
+package pubsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/pubsub"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		topic   string
+		match   bool
+	}{
+		{"chat", "chat", true},
+		{"chat", "chats", false},
+		{"chat.lobby", "chat.lobby", true},
+		{"chat.*", "chat.lobby", true},
+		{"chat.*", "chat", false},
+		{"chat.*", "chat.lobby.typing", false},
+		{"*.lobby", "chat.lobby", true},
+		{"chat.>", "chat.lobby", true},
+		{"chat.>", "chat.lobby.typing", true},
+		{"chat.>", "chat", false},
+		{">", "anything.at.all", true},
+		{"chat.*.typing", "chat.lobby.typing", true},
+		{"chat.*.typing", "chat.lobby.left", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pattern+" "+tc.topic, func(t *testing.T) {
+			assert.Equal(t, tc.match, pubsub.Match(tc.pattern, tc.topic))
+		})
+	}
+}
+
+type message struct {
+	Text string `json:"text"`
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("same type", func(t *testing.T) {
+		v, err := pubsub.Decode[*message](pubsub.Message{Payload: &message{Text: "a"}})
+		require.NoError(t, err)
+		require.Equal(t, "a", v.Text)
+	})
+
+	t.Run("json bytes", func(t *testing.T) {
+		v, err := pubsub.Decode[*message](pubsub.Message{Payload: []byte(`{"text":"b"}`)})
+		require.NoError(t, err)
+		require.Equal(t, "b", v.Text)
+
+		v, err = pubsub.Decode[*message](pubsub.Message{Payload: json.RawMessage(`{"text":"c"}`)})
+		require.NoError(t, err)
+		require.Equal(t, "c", v.Text)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		_, err := pubsub.Decode[*message](pubsub.Message{Topic: "t", Payload: 1})
+		require.EqualError(t, err, "pubsub: payload on t has type int, want *pubsub_test.message")
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Run("typed channel", func(t *testing.T) {
+		b := pubsub.NewMemory()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch, err := pubsub.Subscribe[*message](ctx, b, "room.*")
+		require.NoError(t, err)
+
+		require.NoError(t, b.Publish(context.Background(), "room.a", &message{Text: "hi"}))
+		require.NoError(t, b.Publish(context.Background(), "room.a", "not a message"))
+		require.NoError(t, b.Publish(context.Background(), "room.b", &message{Text: "there"}))
+
+		assert.Equal(t, "hi", (<-ch).Text)
+		assert.Equal(t, "there", (<-ch).Text)
+
+		cancel()
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("channel was not closed")
+		}
+		require.Eventually(t, func() bool { return b.Subscribers() == 0 }, time.Second, time.Millisecond)
+	})
+
+	t.Run("external broker", func(t *testing.T) {
+		b := newWireBroker()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch, err := pubsub.Subscribe[message](ctx, b, "room.>")
+		require.NoError(t, err)
+
+		require.NoError(t, b.Publish(ctx, "room.a.b", message{Text: "over the wire"}))
+		assert.Equal(t, "over the wire", (<-ch).Text)
+	})
+}
+
+// wireBroker stands in for a broker like NATS or Redis: payloads are serialized on publish and
+// subscribers receive raw bytes.
+type wireBroker struct {
+	mu   sync.Mutex
+	subs map[*wireSubscription]struct{}
+}
+
+func newWireBroker() *wireBroker {
+	return &wireBroker{subs: map[*wireSubscription]struct{}{}}
+}
+
+func (b *wireBroker) Publish(ctx context.Context, topic string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if pubsub.Match(sub.pattern, topic) {
+			sub.ch <- pubsub.Message{Topic: topic, Payload: data}
+		}
+	}
+	return nil
+}
+
+func (b *wireBroker) Subscribe(
+	ctx context.Context,
+	pattern string,
+	opts ...pubsub.SubscribeOption,
+) (pubsub.Subscription, error) {
+	o := pubsub.NewSubscribeOptions(opts...)
+	sub := &wireSubscription{broker: b, pattern: pattern, ch: make(chan pubsub.Message, o.BufferSize)}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	context.AfterFunc(ctx, sub.Unsubscribe)
+	return sub, nil
+}
+
+type wireSubscription struct {
+	broker  *wireBroker
+	pattern string
+	ch      chan pubsub.Message
+	once    sync.Once
+}
+
+func (s *wireSubscription) Messages() <-chan pubsub.Message { return s.ch }
+
+func (s *wireSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.broker.mu.Lock()
+		delete(s.broker.subs, s)
+		close(s.ch)
+		s.broker.mu.Unlock()
+	})
+}
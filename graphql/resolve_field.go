@@ -106,7 +106,7 @@ func resolveField[T, R any](
 
 	next := func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return fieldResolver(rctx)
+		return resolveWithDeadline(rctx, fc, fieldResolver)
 	}
 
 	if middlewareChain != nil {
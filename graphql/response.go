@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -19,6 +20,10 @@ type Response struct {
 	Path       ast.Path        `json:"path,omitempty"`
 	HasNext    *bool           `json:"hasNext,omitempty"`
 	Extensions map[string]any  `json:"extensions,omitempty"`
+
+	// DataWriter writes the data of the response in place of Data when the transport streams the
+	// response, see WithStreamedResponse. It returns the errors raised while writing the data.
+	DataWriter func(w io.Writer) gqlerror.List `json:"-"`
 }
 
 func ErrorResponse(ctx context.Context, messagef string, args ...any) *Response {
@@ -0,0 +1,50 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const streamedResponseCtx key = "streamed_response_context"
+
+// WithStreamedResponse marks the responses of the operations executed with ctx as streamed: their
+// data is written by Response.DataWriter as the transport writes the response, instead of being
+// buffered in Response.Data first. Response middlewares then see no Data.
+func WithStreamedResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamedResponseCtx, true)
+}
+
+// IsResponseStreamed reports whether ctx is marked with WithStreamedResponse.
+func IsResponseStreamed(ctx context.Context) bool {
+	streamed, _ := ctx.Value(streamedResponseCtx).(bool)
+	return streamed
+}
+
+// SetData sets the data of the response, marshaling it into Data right away unless the response
+// is streamed. ctx must be the response context data was resolved with.
+func (r *Response) SetData(ctx context.Context, data Marshaler) {
+	if !IsResponseStreamed(ctx) {
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		r.Data = buf.Bytes()
+		return
+	}
+
+	r.DataWriter = func(w io.Writer) gqlerror.List {
+		// marshalers of scalars add their errors to the response context while writing
+		resCtx := getResponseContext(ctx)
+		resCtx.errorsMu.Lock()
+		n := len(resCtx.errors)
+		resCtx.errorsMu.Unlock()
+
+		data.MarshalGQL(w)
+
+		if errs := GetErrors(ctx); len(errs) > n {
+			return errs[n:]
+		}
+		return nil
+	}
+}
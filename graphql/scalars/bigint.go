@@ -0,0 +1,75 @@
+package scalars
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// maxSafeInteger is the largest integer JSON clients can read as a number without losing
+// precision, 2^53-1.
+var maxSafeInteger = big.NewInt(1<<53 - 1)
+
+// MarshalBigInt writes i as a number when it is a safe integer for JSON clients, and as a
+// string otherwise. A nil i is written as null.
+func MarshalBigInt(i *big.Int) graphql.Marshaler {
+	if i == nil {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(writeBigInt(i))
+}
+
+// UnmarshalBigInt reads an integer of any size from a number or a string of digits.
+func UnmarshalBigInt(v any) (*big.Int, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = string(v)
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%g is not an integer", v)
+		}
+		i, _ := big.NewFloat(v).Int(nil)
+		return i, nil
+	default:
+		return nil, fmt.Errorf("%T is not a big int", v)
+	}
+
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%q is not an integer", s)
+	}
+	return i, nil
+}
+
+func MarshalBigIntContext(i *big.Int) graphql.ContextMarshaler {
+	if i == nil {
+		return marshalContext(errors.New("big int must not be nil"), nil)
+	}
+	return marshalContext(nil, writeBigInt(i))
+}
+
+func UnmarshalBigIntContext(ctx context.Context, v any) (*big.Int, error) {
+	return UnmarshalBigInt(v)
+}
+
+func writeBigInt(i *big.Int) func(w io.Writer) {
+	if new(big.Int).Abs(i).Cmp(maxSafeInteger) > 0 {
+		return writeString(i.String())
+	}
+	return func(w io.Writer) {
+		_, _ = io.WriteString(w, i.String())
+	}
+}
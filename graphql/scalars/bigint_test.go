@@ -0,0 +1,46 @@
+package scalars
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigInt(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, "42", m2s(MarshalBigInt(big.NewInt(42))))
+		assert.Equal(t, "9007199254740991", m2s(MarshalBigInt(big.NewInt(1<<53-1))))
+		assert.Equal(t, `"9007199254740992"`, m2s(MarshalBigInt(big.NewInt(1<<53))))
+		assert.Equal(t, `"-9007199254740992"`, m2s(MarshalBigInt(big.NewInt(-1<<53))))
+		assert.Equal(t, `"123456789012345678901234567890"`, m2s(MarshalBigInt(huge)))
+		assert.Equal(t, "null", m2s(MarshalBigInt(nil)))
+
+		_, err := cm2s(MarshalBigIntContext(nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		for input, expected := range map[any]*big.Int{
+			"123456789012345678901234567890":              huge,
+			json.Number("123456789012345678901234567890"): huge,
+			"-12":         big.NewInt(-12),
+			12:            big.NewInt(12),
+			int64(12):     big.NewInt(12),
+			float64(1e15): big.NewInt(1e15),
+		} {
+			v, err := UnmarshalBigInt(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, 0, expected.Cmp(v), input)
+		}
+
+		for _, input := range []any{"1.5", "12abc", "", 1.5, true} {
+			_, err := UnmarshalBigInt(input)
+			assert.Error(t, err, input)
+		}
+	})
+}
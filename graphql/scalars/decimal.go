@@ -0,0 +1,81 @@
+package scalars
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// decimalPrecision is the number of digits written after the point for decimals that have no
+// exact decimal representation, eg 1/3. It matches the precision of IEEE 754 decimal128.
+const decimalPrecision = 34
+
+// MarshalDecimal writes d as a string, so clients do not lose precision by reading it as a
+// float. A nil d is written as null.
+func MarshalDecimal(d *big.Rat) graphql.Marshaler {
+	if d == nil {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(writeString(formatDecimal(d)))
+}
+
+// UnmarshalDecimal reads an exact decimal from a string such as "12.50" or "1e-3", or from a
+// number.
+func UnmarshalDecimal(v any) (*big.Rat, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = string(v)
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), nil
+	case int64:
+		return new(big.Rat).SetInt64(v), nil
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, errors.New("decimal must be finite")
+		}
+		// the shortest representation of the float, 0.1 reads as 1/10 and not as its binary value
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return nil, fmt.Errorf("%T is not a decimal", v)
+	}
+
+	// SetString accepts fractions and the special values of floats, which are not decimals
+	if strings.ContainsFunc(s, func(r rune) bool { return !strings.ContainsRune("0123456789.eE+-", r) }) {
+		return nil, fmt.Errorf("%q is not a decimal", s)
+	}
+	d, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal", s)
+	}
+	return d, nil
+}
+
+func MarshalDecimalContext(d *big.Rat) graphql.ContextMarshaler {
+	if d == nil {
+		return marshalContext(errors.New("decimal must not be nil"), nil)
+	}
+	return marshalContext(nil, writeString(formatDecimal(d)))
+}
+
+func UnmarshalDecimalContext(ctx context.Context, v any) (*big.Rat, error) {
+	return UnmarshalDecimal(v)
+}
+
+// formatDecimal writes d exactly when it has a finite decimal representation, and rounded to
+// decimalPrecision digits otherwise.
+func formatDecimal(d *big.Rat) string {
+	if digits, exact := d.FloatPrec(); exact {
+		return d.FloatString(digits)
+	}
+	return d.FloatString(decimalPrecision)
+}
@@ -0,0 +1,45 @@
+package scalars
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimal(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, `"12.5"`, m2s(MarshalDecimal(big.NewRat(25, 2))))
+		assert.Equal(t, `"-3"`, m2s(MarshalDecimal(big.NewRat(-3, 1))))
+		assert.Equal(t, `"0.3333333333333333333333333333333333"`, m2s(MarshalDecimal(big.NewRat(1, 3))))
+		assert.Equal(t, "null", m2s(MarshalDecimal(nil)))
+
+		_, err := cm2s(MarshalDecimalContext(nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		for input, expected := range map[any]*big.Rat{
+			"12.50":               big.NewRat(25, 2),
+			"1e-3":                big.NewRat(1, 1000),
+			"-0.1":                big.NewRat(-1, 10),
+			json.Number("0.1"):    big.NewRat(1, 10),
+			0.1:                   big.NewRat(1, 10),
+			7:                     big.NewRat(7, 1),
+			int64(7):              big.NewRat(7, 1),
+			"1000000000000000.01": big.NewRat(100000000000000001, 100),
+		} {
+			v, err := UnmarshalDecimal(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, 0, expected.Cmp(v), "%v: %v", input, v)
+		}
+
+		for _, input := range []any{"1/3", "Inf", "NaN", "0x10", "abc", math.Inf(1), math.NaN(), true} {
+			_, err := UnmarshalDecimal(input)
+			assert.Error(t, err, input)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package scalars
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalEmailAddress writes s as a string, an invalid address is written as null.
+func MarshalEmailAddress(s string) graphql.Marshaler {
+	return marshal(checkEmailAddress(s), writeString(s))
+}
+
+// UnmarshalEmailAddress reads a bare email address as defined by RFC 5322, eg
+// "gopher@example.com". Display names and angle brackets are rejected.
+func UnmarshalEmailAddress(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%T is not an email address", v)
+	}
+	if err := checkEmailAddress(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func MarshalEmailAddressContext(s string) graphql.ContextMarshaler {
+	return marshalContext(checkEmailAddress(s), writeString(s))
+}
+
+func UnmarshalEmailAddressContext(ctx context.Context, v any) (string, error) {
+	return UnmarshalEmailAddress(v)
+}
+
+func checkEmailAddress(s string) error {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s {
+		return fmt.Errorf("%q is not an email address", s)
+	}
+	return nil
+}
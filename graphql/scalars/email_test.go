@@ -0,0 +1,31 @@
+package scalars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailAddress(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, `"gopher@example.com"`, m2s(MarshalEmailAddress("gopher@example.com")))
+		assert.Equal(t, "null", m2s(MarshalEmailAddress("gopher")))
+
+		_, err := cm2s(MarshalEmailAddressContext("gopher"))
+		assert.Error(t, err)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		for _, s := range []string{"gopher@example.com", "first.last+tag@sub.example.co.uk"} {
+			v, err := UnmarshalEmailAddress(s)
+			require.NoError(t, err, s)
+			assert.Equal(t, s, v)
+		}
+
+		for _, s := range []any{"gopher", "@example.com", "Gopher <gopher@example.com>", " gopher@example.com", 1} {
+			_, err := UnmarshalEmailAddress(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
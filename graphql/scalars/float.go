@@ -0,0 +1,49 @@
+package scalars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalNonNegativeFloat writes f, a negative or non finite f is written as null.
+func MarshalNonNegativeFloat(f float64) graphql.Marshaler {
+	return marshal(checkNonNegativeFloat(f), writeFloat(f))
+}
+
+// UnmarshalNonNegativeFloat reads a number greater than or equal to 0.
+func UnmarshalNonNegativeFloat(v any) (float64, error) {
+	f, err := graphql.UnmarshalFloat(v)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkNonNegativeFloat(f); err != nil {
+		return 0, err
+	}
+	return f, nil
+}
+
+func MarshalNonNegativeFloatContext(f float64) graphql.ContextMarshaler {
+	return marshalContext(checkNonNegativeFloat(f), writeFloat(f))
+}
+
+func UnmarshalNonNegativeFloatContext(ctx context.Context, v any) (float64, error) {
+	return UnmarshalNonNegativeFloat(v)
+}
+
+func checkNonNegativeFloat(f float64) error {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return errors.New("float must be finite")
+	}
+	return checkMin(f, 0)
+}
+
+func writeFloat(f float64) func(w io.Writer) {
+	return func(w io.Writer) {
+		fmt.Fprintf(w, "%g", f)
+	}
+}
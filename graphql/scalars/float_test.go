@@ -0,0 +1,23 @@
+package scalars
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonNegativeFloat(t *testing.T) {
+	assert.Equal(t, "1.5", m2s(MarshalNonNegativeFloat(1.5)))
+	assert.Equal(t, "null", m2s(MarshalNonNegativeFloat(-0.5)))
+	assert.Equal(t, "null", m2s(MarshalNonNegativeFloat(math.Inf(1))))
+	_, err := cm2s(MarshalNonNegativeFloatContext(math.NaN()))
+	assert.Error(t, err)
+
+	v, err := UnmarshalNonNegativeFloat(0.25)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.25, v, 0)
+	_, err = UnmarshalNonNegativeFloat(int64(-2))
+	assert.Error(t, err)
+}
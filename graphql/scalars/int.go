@@ -0,0 +1,70 @@
+package scalars
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalNonNegativeInt writes i, a negative i is written as null.
+func MarshalNonNegativeInt(i int) graphql.Marshaler {
+	return marshal(checkMin(i, 0), writeInt(i))
+}
+
+// UnmarshalNonNegativeInt reads an integer greater than or equal to 0.
+func UnmarshalNonNegativeInt(v any) (int, error) {
+	return unmarshalMinInt(v, 0)
+}
+
+func MarshalNonNegativeIntContext(i int) graphql.ContextMarshaler {
+	return marshalContext(checkMin(i, 0), writeInt(i))
+}
+
+func UnmarshalNonNegativeIntContext(ctx context.Context, v any) (int, error) {
+	return UnmarshalNonNegativeInt(v)
+}
+
+// MarshalPositiveInt writes i, an i lower than 1 is written as null.
+func MarshalPositiveInt(i int) graphql.Marshaler {
+	return marshal(checkMin(i, 1), writeInt(i))
+}
+
+// UnmarshalPositiveInt reads an integer greater than 0.
+func UnmarshalPositiveInt(v any) (int, error) {
+	return unmarshalMinInt(v, 1)
+}
+
+func MarshalPositiveIntContext(i int) graphql.ContextMarshaler {
+	return marshalContext(checkMin(i, 1), writeInt(i))
+}
+
+func UnmarshalPositiveIntContext(ctx context.Context, v any) (int, error) {
+	return UnmarshalPositiveInt(v)
+}
+
+func unmarshalMinInt(v any, least int) (int, error) {
+	i, err := graphql.UnmarshalInt(v)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkMin(i, least); err != nil {
+		return 0, err
+	}
+	return i, nil
+}
+
+func checkMin[N int | float64](n, least N) error {
+	if n < least {
+		return fmt.Errorf("%v is lower than %v", n, least)
+	}
+	return nil
+}
+
+func writeInt(i int) func(w io.Writer) {
+	return func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Itoa(i))
+	}
+}
@@ -0,0 +1,34 @@
+package scalars
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonNegativeInt(t *testing.T) {
+	assert.Equal(t, "0", m2s(MarshalNonNegativeInt(0)))
+	assert.Equal(t, "null", m2s(MarshalNonNegativeInt(-1)))
+	_, err := cm2s(MarshalNonNegativeIntContext(-1))
+	assert.Error(t, err)
+
+	v, err := UnmarshalNonNegativeInt(int64(0))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v)
+	_, err = UnmarshalNonNegativeInt(-1)
+	assert.EqualError(t, err, "-1 is lower than 0")
+}
+
+func TestPositiveInt(t *testing.T) {
+	assert.Equal(t, "3", m2s(MarshalPositiveInt(3)))
+	assert.Equal(t, "null", m2s(MarshalPositiveInt(0)))
+	_, err := cm2s(MarshalPositiveIntContext(0))
+	assert.Error(t, err)
+
+	v, err := UnmarshalPositiveInt("3")
+	require.NoError(t, err)
+	assert.Equal(t, 3, v)
+	_, err = UnmarshalPositiveInt(0)
+	assert.EqualError(t, err, "0 is lower than 1")
+}
@@ -0,0 +1,58 @@
+package scalars
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// JSON is an encoded JSON value, like json.RawMessage. It is a distinct type so that code
+// generated for it does not depend on how the standard library declares json.RawMessage.
+type JSON []byte
+
+// MarshalJSON returns j as the encoding of itself, or null when it is empty.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.RawMessage(j).MarshalJSON()
+}
+
+// UnmarshalJSON keeps a copy of data.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	return (*json.RawMessage)(j).UnmarshalJSON(data)
+}
+
+// MarshalJSON writes raw as is, it should hold a valid JSON value. An empty raw is written as
+// null.
+func MarshalJSON(raw JSON) graphql.Marshaler {
+	if len(raw) == 0 || !json.Valid(raw) {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(writeRaw(raw))
+}
+
+// UnmarshalJSON encodes any input value back to JSON, including objects, lists and null.
+func UnmarshalJSON(v any) (JSON, error) {
+	return json.Marshal(v)
+}
+
+func MarshalJSONContext(raw JSON) graphql.ContextMarshaler {
+	if len(raw) == 0 {
+		return marshalContext(nil, writeRaw(JSON("null")))
+	}
+	if !json.Valid(raw) {
+		return marshalContext(errors.New("JSON value is not valid JSON"), nil)
+	}
+	return marshalContext(nil, writeRaw(raw))
+}
+
+func UnmarshalJSONContext(ctx context.Context, v any) (JSON, error) {
+	return UnmarshalJSON(v)
+}
+
+func writeRaw(raw JSON) func(w io.Writer) {
+	return func(w io.Writer) {
+		_, _ = w.Write(raw)
+	}
+}
@@ -0,0 +1,44 @@
+package scalars
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, `{"a":[1,"b"]}`, m2s(MarshalJSON(JSON(`{"a":[1,"b"]}`))))
+		assert.Equal(t, "null", m2s(MarshalJSON(nil)))
+		assert.Equal(t, "null", m2s(MarshalJSON(JSON(`{"a"`))))
+
+		s, err := cm2s(MarshalJSONContext(nil))
+		require.NoError(t, err)
+		assert.Equal(t, "null", s)
+
+		_, err = cm2s(MarshalJSONContext(JSON(`{"a"`)))
+		assert.Error(t, err)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		v, err := UnmarshalJSON(map[string]any{"a": []any{int64(1), "b", nil}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"a":[1,"b",null]}`, string(v))
+
+		v, err = UnmarshalJSON(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(v))
+	})
+}
+
+func TestJSONEncoding(t *testing.T) {
+	b, err := json.Marshal(struct{ V JSON }{V: JSON(`{"a":1}`)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"V":{"a":1}}`, string(b))
+
+	var v struct{ V JSON }
+	require.NoError(t, json.Unmarshal([]byte(`{"V":[1, 2]}`), &v))
+	assert.Equal(t, "[1, 2]", string(v.V))
+}
@@ -0,0 +1,46 @@
+// Package scalars implements common custom scalars with the semantics of graphql-scalars.dev.
+//
+// Each scalar X comes with MarshalX and UnmarshalX functions, and with MarshalXContext and
+// UnmarshalXContext variants. The Context variants return an error when a resolver returns a
+// value the scalar does not allow, the others write null instead.
+//
+// gqlgen binds them automatically to the scalars declared in the schema with their name and the
+// URL of their specification, eg
+//
+//	scalar DateTime @specifiedBy(url: "https://the-guild.dev/graphql/scalars/docs/scalars/date-time")
+//
+// Any other scalar can be bound to them in the models section of the config.
+package scalars
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// marshal returns a marshaler running write, or writing null when err is set.
+func marshal(err error, write func(w io.Writer)) graphql.Marshaler {
+	if err != nil {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(write)
+}
+
+// marshalContext returns a marshaler running write, or failing with err when it is set.
+func marshalContext(err error, write func(w io.Writer)) graphql.ContextMarshaler {
+	return graphql.ContextWriterFunc(func(ctx context.Context, w io.Writer) error {
+		if err != nil {
+			return err
+		}
+		write(w)
+		return nil
+	})
+}
+
+func writeString(s string) func(w io.Writer) {
+	return func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(s))
+	}
+}
@@ -0,0 +1,20 @@
+package scalars
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func m2s(m graphql.Marshaler) string {
+	var b bytes.Buffer
+	m.MarshalGQL(&b)
+	return b.String()
+}
+
+func cm2s(m graphql.ContextMarshaler) (string, error) {
+	var b bytes.Buffer
+	err := m.MarshalGQLContext(context.Background(), &b)
+	return b.String(), err
+}
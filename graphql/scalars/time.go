@@ -0,0 +1,80 @@
+package scalars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+var localTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]{1,3})?$`)
+
+// MarshalLocalTime writes the clock time of t as HH:mm:ss, or HH:mm:ss.SSS when it has
+// milliseconds.
+func MarshalLocalTime(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(writeString(formatLocalTime(t)))
+}
+
+// UnmarshalLocalTime reads a clock time formatted as HH:mm:ss or HH:mm:ss.SSS, without date or
+// offset. The time returned is on January 1 of year 0, in UTC.
+func UnmarshalLocalTime(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%T is not a local time", v)
+	}
+	if !localTimePattern.MatchString(s) {
+		return time.Time{}, errors.New("local time should be formatted as HH:mm:ss or HH:mm:ss.SSS")
+	}
+	return time.Parse(time.TimeOnly, s)
+}
+
+func MarshalLocalTimeContext(t time.Time) graphql.ContextMarshaler {
+	return marshalContext(nil, writeString(formatLocalTime(t)))
+}
+
+func UnmarshalLocalTimeContext(ctx context.Context, v any) (time.Time, error) {
+	return UnmarshalLocalTime(v)
+}
+
+func formatLocalTime(t time.Time) string {
+	if t.Nanosecond()/int(time.Millisecond) == 0 {
+		return t.Format(time.TimeOnly)
+	}
+	return t.Format("15:04:05.000")
+}
+
+// MarshalDateTime writes t in RFC 3339 with its offset, the zero time is written as null.
+func MarshalDateTime(t time.Time) graphql.Marshaler {
+	if t.IsZero() {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(writeString(t.Format(time.RFC3339Nano)))
+}
+
+// UnmarshalDateTime reads a date time in RFC 3339, the offset is required. The offset of the
+// value is kept in the location of the time returned.
+func UnmarshalDateTime(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%T is not a date time", v)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, errors.New("date time should be an RFC 3339 string with an offset")
+	}
+	return t, nil
+}
+
+func MarshalDateTimeContext(t time.Time) graphql.ContextMarshaler {
+	if t.IsZero() {
+		return marshalContext(nil, graphql.Null.MarshalGQL)
+	}
+	return marshalContext(nil, writeString(t.Format(time.RFC3339Nano)))
+}
+
+func UnmarshalDateTimeContext(ctx context.Context, v any) (time.Time, error) {
+	return UnmarshalDateTime(v)
+}
@@ -0,0 +1,56 @@
+package scalars
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalTime(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, `"09:05:00"`, m2s(MarshalLocalTime(time.Date(2024, 1, 2, 9, 5, 0, 0, time.UTC))))
+		assert.Equal(t, `"23:59:59.120"`, m2s(MarshalLocalTime(time.Date(0, 1, 1, 23, 59, 59, 120_456_000, time.UTC))))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		for _, s := range []string{"00:00:00", "23:59:59", "12:30:45.1", "12:30:45.123"} {
+			v, err := UnmarshalLocalTime(s)
+			require.NoError(t, err, s)
+			assert.Equal(t, s, v.Format("15:04:05.999"))
+		}
+
+		for _, s := range []any{"24:00:00", "12:30", "12:30:45.1234", "12:30:45Z", "12:30:45+01:00", 1230} {
+			_, err := UnmarshalLocalTimeContext(context.Background(), s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestDateTime(t *testing.T) {
+	offset := time.FixedZone("", 2*60*60)
+
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, `"2024-03-01T10:20:30.5+02:00"`, m2s(MarshalDateTime(time.Date(2024, 3, 1, 10, 20, 30, 500_000_000, offset))))
+		assert.Equal(t, "null", m2s(MarshalDateTime(time.Time{})))
+
+		s, err := cm2s(MarshalDateTimeContext(time.Time{}))
+		require.NoError(t, err)
+		assert.Equal(t, "null", s)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		v, err := UnmarshalDateTime("2024-03-01T10:20:30+02:00")
+		require.NoError(t, err)
+		assert.True(t, v.Equal(time.Date(2024, 3, 1, 10, 20, 30, 0, offset)))
+		_, o := v.Zone()
+		assert.Equal(t, 2*60*60, o)
+
+		for _, s := range []any{"2024-03-01T10:20:30", "2024-03-01", "10:20:30Z", 1709281230} {
+			_, err := UnmarshalDateTime(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
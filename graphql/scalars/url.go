@@ -0,0 +1,52 @@
+package scalars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalURL writes u as a string, a nil or relative u is written as null.
+func MarshalURL(u *url.URL) graphql.Marshaler {
+	if u == nil {
+		return graphql.Null
+	}
+	return marshal(checkURL(u), writeString(u.String()))
+}
+
+// UnmarshalURL reads an absolute URL.
+func UnmarshalURL(v any) (*url.URL, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a URL", v)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a URL", s)
+	}
+	if err := checkURL(u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func MarshalURLContext(u *url.URL) graphql.ContextMarshaler {
+	if u == nil {
+		return marshalContext(errors.New("URL must not be nil"), nil)
+	}
+	return marshalContext(checkURL(u), writeString(u.String()))
+}
+
+func UnmarshalURLContext(ctx context.Context, v any) (*url.URL, error) {
+	return UnmarshalURL(v)
+}
+
+func checkURL(u *url.URL) error {
+	if !u.IsAbs() || (u.Host == "" && u.Opaque == "") {
+		return fmt.Errorf("%q is not an absolute URL", u.String())
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package scalars
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURL(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		assert.Equal(t, `"https://example.com/a?b=c"`, m2s(MarshalURL(&url.URL{Scheme: "https", Host: "example.com", Path: "/a", RawQuery: "b=c"})))
+		assert.Equal(t, "null", m2s(MarshalURL(nil)))
+		assert.Equal(t, "null", m2s(MarshalURL(&url.URL{Path: "/a"})))
+
+		_, err := cm2s(MarshalURLContext(&url.URL{Path: "/a"}))
+		assert.Error(t, err)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		for _, s := range []string{"https://example.com", "http://localhost:8080/a#b", "mailto:gopher@example.com"} {
+			v, err := UnmarshalURL(s)
+			require.NoError(t, err, s)
+			assert.Equal(t, s, v.String())
+		}
+
+		for _, s := range []any{"/a/b", "example.com", "https://", "http://[::1", 1} {
+			_, err := UnmarshalURL(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const operationTimeoutCtx key = "operation_timeout_context"
+
+// WithOperationTimeout gives the fields resolved with the returned context until timeout, those
+// without a Timeout of their own resolve to null with a TIMEOUT error when it passes.
+func WithOperationTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return context.WithValue(ctx, operationTimeoutCtx, true), cancel
+}
+
+// ResolveWithTimeout calls resolver with a context ending after timeout. When timeout passes before
+// resolver returns, it returns a TIMEOUT error right away and drops what resolver returns later.
+func ResolveWithTimeout(ctx context.Context, timeout time.Duration, resolver Resolver) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return resolveBeforeDone(ctx, resolver)
+}
+
+// resolveWithDeadline applies the timeout of the field being resolved, or the deadline of the
+// operation to the fields with a method or a resolver.
+func resolveWithDeadline(ctx context.Context, fc *FieldContext, resolver Resolver) (any, error) {
+	if fc.Timeout > 0 {
+		return ResolveWithTimeout(ctx, fc.Timeout, resolver)
+	}
+	if fc.IsMethod && ctx.Value(operationTimeoutCtx) != nil {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return resolveBeforeDone(ctx, resolver)
+	}
+	return resolver(ctx)
+}
+
+// resolveBeforeDone calls resolver in a goroutine and returns what it returns, or an error as soon
+// as ctx is done. A panic of resolver is raised again in the calling goroutine, unless ctx is done
+// first.
+func resolveBeforeDone(ctx context.Context, resolver Resolver) (any, error) {
+	type result struct {
+		res      any
+		err      error
+		panicked bool
+		r        any
+	}
+	// buffered, the resolver returning after ctx is done does not block on it
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{panicked: true, r: r}
+			}
+		}()
+		res, err := resolver(ctx)
+		done <- result{res: res, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.panicked {
+			panic(r.r)
+		}
+		return r.res, r.err
+	case <-ctx.Done():
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, ctx.Err()
+		}
+		err := gqlerror.Errorf("field timed out")
+		errcode.Set(err, errcode.Timeout)
+		return nil, ErrorOnPath(ctx, err)
+	}
+}
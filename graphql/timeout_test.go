@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+func TestResolveWithTimeout(t *testing.T) {
+	ctx := WithFieldContext(context.Background(), &FieldContext{Field: CollectedField{Field: &ast.Field{Alias: "slow"}}})
+
+	t.Run("returns the result of the resolver", func(t *testing.T) {
+		res, err := ResolveWithTimeout(ctx, time.Second, func(ctx context.Context) (any, error) {
+			_, ok := ctx.Deadline()
+			require.True(t, ok)
+			return "fast", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "fast", res)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		res, err := ResolveWithTimeout(ctx, time.Millisecond, func(ctx context.Context) (any, error) {
+			<-release
+			return "late", nil
+		})
+		require.Nil(t, res)
+		var gqlErr *gqlerror.Error
+		require.ErrorAs(t, err, &gqlErr)
+		require.Equal(t, "field timed out", gqlErr.Message)
+		require.Equal(t, ast.Path{ast.PathName("slow")}, gqlErr.Path)
+		require.Equal(t, errcode.Timeout, gqlErr.Extensions["code"])
+	})
+
+	t.Run("returns the error of cancelled contexts", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := ResolveWithTimeout(ctx, time.Second, func(ctx context.Context) (any, error) {
+			<-release
+			return nil, nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("raises the panics of the resolver", func(t *testing.T) {
+		require.PanicsWithValue(t, "boom", func() {
+			_, _ = ResolveWithTimeout(ctx, time.Second, func(ctx context.Context) (any, error) {
+				panic("boom")
+			})
+		})
+	})
+}
+
+func TestOperationTimeout(t *testing.T) {
+	ctx, cancel := WithOperationTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	release := make(chan struct{})
+	defer close(release)
+	slow := func(ctx context.Context) (any, error) {
+		<-release
+		return "late", nil
+	}
+
+	_, err := resolveWithDeadline(ctx, &FieldContext{IsMethod: true}, slow)
+	require.Error(t, err)
+
+	<-ctx.Done()
+	res, err := resolveWithDeadline(ctx, &FieldContext{}, func(ctx context.Context) (any, error) {
+		return "field", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "field", res)
+}
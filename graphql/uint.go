@@ -12,7 +12,7 @@ import (
 
 func MarshalUint(i uint) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatUint(uint64(i), 10))
+		writeUint(w, uint64(i))
 	})
 }
 
@@ -22,7 +22,7 @@ func UnmarshalUint(v any) (uint, error) {
 
 func MarshalUint8(i uint8) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatUint(uint64(i), 10))
+		writeUint(w, uint64(i))
 	})
 }
 
@@ -32,7 +32,7 @@ func UnmarshalUint8(v any) (uint8, error) {
 
 func MarshalUint16(i uint16) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatUint(uint64(i), 10))
+		writeUint(w, uint64(i))
 	})
 }
 
@@ -42,7 +42,7 @@ func UnmarshalUint16(v any) (uint16, error) {
 
 func MarshalUint32(i uint32) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatUint(uint64(i), 10))
+		writeUint(w, uint64(i))
 	})
 }
 
@@ -52,7 +52,7 @@ func UnmarshalUint32(v any) (uint32, error) {
 
 func MarshalUint64(i uint64) Marshaler {
 	return WriterFunc(func(w io.Writer) {
-		_, _ = io.WriteString(w, strconv.FormatUint(i, 10))
+		writeUint(w, i)
 	})
 }
 
@@ -12,6 +12,21 @@ type Upload struct {
 	ContentType string
 }
 
+// UploadStream is implemented by Upload.File when the multipart transport streams uploads instead
+// of buffering them, see transport.MultipartForm.StreamUploads. The file's part of the request
+// body may not have been reached yet when the resolver runs, so the Upload's Filename and
+// ContentType are empty and its Size is -1. They are available from the stream once Open, or the
+// first Read, returned without error.
+//
+// Streams can not seek and must be read in the order the files appear in the request.
+type UploadStream interface {
+	io.ReadSeeker
+	// Open advances the request body to the file's part without reading its content.
+	Open() error
+	Filename() string
+	ContentType() string
+}
+
 func MarshalUpload(f Upload) Marshaler {
 	return WriterFunc(func(w io.Writer) {
 		io.Copy(w, f.File)
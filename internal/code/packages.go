@@ -29,6 +29,7 @@ type (
 		loadErrors            []error
 		buildFlags            []string
 		packagesToCachePrefix string
+		selectiveReload       bool
 
 		numLoadCalls int // stupid test steam. ignore.
 		numNameCalls int // stupid test steam. ignore.
@@ -58,6 +59,15 @@ func PackagePrefixToCache(prefixPath string) func(p *Packages) {
 	}
 }
 
+// WithSelectiveReload option for NewPackages makes ReloadAll keep the cache, instead Evict also
+// drops the cached packages importing or imported by the evicted package. Long running processes
+// like `gqlgen generate --watch` use it to only reload packages that changed.
+func WithSelectiveReload() func(p *Packages) {
+	return func(p *Packages) {
+		p.selectiveReload = true
+	}
+}
+
 // NewPackages creates a new packages cache
 // It will load all packages in the current module, and any packages that are passed to Load or
 // LoadAll
@@ -107,7 +117,7 @@ func (p *Packages) CleanupUserPackages() {
 // ReloadAll will call LoadAll after clearing the package cache, so we can reload
 // packages in the case that the packages have changed
 func (p *Packages) ReloadAll(importPaths ...string) []*packages.Package {
-	if p.packages != nil {
+	if p.packages != nil && !p.selectiveReload {
 		p.CleanupUserPackages()
 	}
 	return p.LoadAll(importPaths...)
@@ -265,7 +275,56 @@ func (p *Packages) AddName(importPath string, name string) {
 // Evict removes a given package import path from the cache. Further calls to Load will fetch it
 // from disk.
 func (p *Packages) Evict(importPath string) {
-	delete(p.packages, importPath)
+	if !p.selectiveReload {
+		delete(p.packages, importPath)
+		return
+	}
+
+	// packages loaded together share their dependencies' types, so everything connected to the
+	// evicted package has to be loaded again for types to stay comparable.
+	importPath = NormalizeVendor(importPath)
+	stale := map[string]bool{importPath: true}
+	for queue := []string{importPath}; len(queue) > 0; queue = queue[1:] {
+		for path, pkg := range p.packages {
+			if stale[path] || p.isCachedPrefix(path) {
+				continue
+			}
+			if importsPackage(pkg, queue[0]) || importsPackage(p.packages[queue[0]], path) {
+				stale[path] = true
+				queue = append(queue, path)
+			}
+		}
+	}
+	for path := range stale {
+		delete(p.packages, path)
+	}
+}
+
+// ReloadsSelectively reports whether the cache was created WithSelectiveReload.
+func (p *Packages) ReloadsSelectively() bool {
+	return p.selectiveReload
+}
+
+// ClearErrors forgets the errors returned by earlier packages.Load calls, errors of the cached
+// packages are still reported by Errors.
+func (p *Packages) ClearErrors() {
+	p.loadErrors = nil
+}
+
+func (p *Packages) isCachedPrefix(importPath string) bool {
+	return p.packagesToCachePrefix != "" && strings.HasPrefix(importPath, p.packagesToCachePrefix)
+}
+
+func importsPackage(pkg *packages.Package, importPath string) bool {
+	if pkg == nil || pkg.Types == nil {
+		return false
+	}
+	for _, imp := range pkg.Types.Imports() {
+		if NormalizeVendor(imp.Path()) == importPath {
+			return true
+		}
+	}
+	return false
 }
 
 func (p *Packages) ModTidy() error {
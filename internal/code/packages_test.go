@@ -40,6 +40,23 @@ func TestPackages(t *testing.T) {
 		require.Equal(t, 2, p.numLoadCalls)
 	})
 
+	t.Run("selective reload evicts connected packages only", func(t *testing.T) {
+		p := initialState(t, WithSelectiveReload())
+		p.LoadAll("github.com/99designs/gqlgen/internal/code/testdata/p")
+		require.Equal(t, 2, p.numLoadCalls)
+
+		p.Evict("github.com/99designs/gqlgen/internal/code/testdata/a")
+		require.Equal(t, 1, p.Count())
+
+		p.ReloadAll(
+			"github.com/99designs/gqlgen/internal/code/testdata/a",
+			"github.com/99designs/gqlgen/internal/code/testdata/b",
+			"github.com/99designs/gqlgen/internal/code/testdata/p",
+		)
+		require.Equal(t, 3, p.numLoadCalls)
+		require.Equal(t, 3, p.Count())
+	})
+
 	t.Run("able to load private package with build tags", func(t *testing.T) {
 		p := initialState(t, WithBuildTags("private"))
 		p.Evict("github.com/99designs/gqlgen/internal/code/testdata/a")
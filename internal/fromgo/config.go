@@ -0,0 +1,117 @@
+package fromgo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	yamlast "github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+// WriteSchema writes the schema of r as SDL, definitions separated by blank lines.
+func (r *Result) WriteSchema(w io.Writer) {
+	for i, def := range r.Schema.Definitions {
+		if i > 0 {
+			io.WriteString(w, "\n")
+		}
+		doc := &ast.SchemaDocument{Definitions: ast.DefinitionList{def}}
+		formatter.NewFormatter(w, formatter.WithIndent("  ")).FormatSchemaDocument(doc)
+	}
+}
+
+// model is the config file form of a binding, TypeMapEntry would write out every field setting.
+type model struct {
+	Model      []string                  `yaml:"model"`
+	Fields     map[string]modelField     `yaml:"fields,omitempty"`
+	EnumValues map[string]modelEnumValue `yaml:"enum_values,omitempty"`
+}
+
+type modelField struct {
+	FieldName string `yaml:"fieldName"`
+}
+
+type modelEnumValue struct {
+	Value string `yaml:"value"`
+}
+
+// WriteModels adds models to the models section of the config file at filename, keeping the rest
+// of the file, comments included, as it is. It returns the names of the models left out because
+// the file already binds them.
+func WriteModels(filename string, models config.TypeMap) ([]string, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config: %w", err)
+	}
+	var existing map[string]any
+	if err := yaml.Unmarshal(b, &existing); err != nil {
+		return nil, fmt.Errorf("unable to parse config: %w", err)
+	}
+	bound, _ := existing["models"].(map[string]any)
+
+	var kept []string
+	add := map[string]model{}
+	for name, entry := range models {
+		if _, ok := bound[name]; ok {
+			kept = append(kept, name)
+			continue
+		}
+		m := model{Model: entry.Model}
+		for field, f := range entry.Fields {
+			if m.Fields == nil {
+				m.Fields = map[string]modelField{}
+			}
+			m.Fields[field] = modelField{FieldName: f.FieldName}
+		}
+		for value, v := range entry.EnumValues {
+			if m.EnumValues == nil {
+				m.EnumValues = map[string]modelEnumValue{}
+			}
+			m.EnumValues[value] = modelEnumValue{Value: v.Value}
+		}
+		add[name] = m
+	}
+	sort.Strings(kept)
+	if len(add) == 0 {
+		return kept, nil
+	}
+
+	file, err := parser.ParseBytes(b, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse config: %w", err)
+	}
+	section, hasSection := existing["models"]
+	switch {
+	case bound != nil:
+		err = edit(file, "$.models", add, (*yaml.Path).MergeFromReader)
+	case hasSection && section == nil:
+		err = edit(file, "$.models", add, (*yaml.Path).ReplaceWithReader)
+	default:
+		err = edit(file, "$", map[string]any{"models": add}, (*yaml.Path).MergeFromReader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return kept, os.WriteFile(filename, []byte(strings.TrimRight(file.String(), "\n")+"\n"), 0o644)
+}
+
+func edit(file *yamlast.File, path string, v any, apply func(*yaml.Path, *yamlast.File, io.Reader) error) error {
+	out, err := yaml.MarshalWithOptions(v, yaml.IndentSequence(true))
+	if err != nil {
+		return err
+	}
+	p, err := yaml.PathString(path)
+	if err != nil {
+		return err
+	}
+	return apply(p, file, bytes.NewReader(out))
+}
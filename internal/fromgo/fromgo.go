@@ -0,0 +1,554 @@
+// Package fromgo extracts a GraphQL schema from the Go types of a package, so projects written
+// code first can move to gqlgen without rewriting their models.
+package fromgo
+
+import (
+	"errors"
+	"fmt"
+	goast "go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+// Result is the schema extracted from a package along with the models binding it to the Go types.
+type Result struct {
+	Schema *ast.SchemaDocument
+	Models config.TypeMap
+	// Warnings lists what could not be mapped, like fields of unsupported types.
+	Warnings []string
+}
+
+// Extract maps the exported types of the package at importPath to schema types:
+//
+//   - structs become objects, or input objects when their name ends in Input,
+//   - interfaces become interfaces, or unions of their implementations when none of their
+//     methods can be used as a field,
+//   - string types become enums when they have constants, scalars otherwise.
+//
+// Field names come from the gqlgen struct tag, then the json tag, then the Go name. Fields that
+// are pointers are nullable, the others are not. Fields of types that can't be mapped are left
+// out and reported in the warnings.
+func Extract(importPath string) (*Result, error) {
+	// the types of the imported packages are needed to map fields of types like time.Time
+	pkgs, err := packages.Load(&packages.Config{Mode: loadMode}, importPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("unable to load %s", importPath)
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, pkg.Errors[0]
+	}
+
+	e := &extractor{
+		pkg:     pkg,
+		docs:    docs(pkg.Syntax),
+		kinds:   map[*types.TypeName]ast.DefinitionKind{},
+		enums:   map[*types.TypeName][]*types.Const{},
+		scalars: map[string]bool{},
+		res: &Result{
+			Schema: &ast.SchemaDocument{},
+			Models: config.TypeMap{},
+		},
+	}
+	return e.extract()
+}
+
+const loadMode = packages.NeedName |
+	packages.NeedTypes |
+	packages.NeedSyntax |
+	packages.NeedTypesInfo |
+	packages.NeedImports |
+	packages.NeedDeps
+
+type extractor struct {
+	pkg     *packages.Package
+	docs    map[token.Pos]string
+	kinds   map[*types.TypeName]ast.DefinitionKind
+	enums   map[*types.TypeName][]*types.Const
+	scalars map[string]bool
+	res     *Result
+}
+
+func (e *extractor) extract() (*Result, error) {
+	scope := e.pkg.Types.Scope()
+
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !c.Exported() {
+			continue
+		}
+		if tn := e.localType(c.Type()); tn != nil && isString(tn.Type()) {
+			e.enums[tn] = append(e.enums[tn], c)
+		}
+	}
+
+	var names []*types.TypeName
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !tn.Exported() || tn.IsAlias() {
+			continue
+		}
+		switch u := tn.Type().Underlying().(type) {
+		case *types.Struct:
+			if strings.HasSuffix(tn.Name(), "Input") {
+				e.kinds[tn] = ast.InputObject
+			} else {
+				e.kinds[tn] = ast.Object
+			}
+		case *types.Interface:
+			if u.NumMethods() == 0 {
+				continue
+			}
+			e.kinds[tn] = ast.Interface
+		default:
+			switch {
+			case len(e.enums[tn]) > 0:
+				e.kinds[tn] = ast.Enum
+			case isString(tn.Type()):
+				// gqlgen casts scalars bound to string types
+				e.kinds[tn] = ast.Scalar
+			default:
+				continue
+			}
+		}
+		names = append(names, tn)
+	}
+
+	defs := map[*types.TypeName]*ast.Definition{}
+	for _, tn := range names {
+		def := &ast.Definition{
+			Kind:        e.kinds[tn],
+			Name:        tn.Name(),
+			Description: e.docs[tn.Pos()],
+		}
+		e.res.Models[def.Name] = config.TypeMapEntry{Model: config.StringList{e.goName(tn.Name())}}
+		switch def.Kind {
+		case ast.Object, ast.InputObject:
+			e.structFields(def, tn.Type().Underlying().(*types.Struct), map[string]bool{})
+		case ast.Interface:
+			e.methodFields(def, tn.Type().Underlying().(*types.Interface))
+		case ast.Enum:
+			e.enumValues(def, tn)
+		}
+		defs[tn] = def
+	}
+
+	// objects implement the interfaces they satisfy, interfaces without fields become unions of
+	// the objects implementing them.
+	for _, iface := range names {
+		if e.kinds[iface] != ast.Interface {
+			continue
+		}
+		it := iface.Type().Underlying().(*types.Interface)
+		idef := defs[iface]
+		for _, tn := range names {
+			if e.kinds[tn] != ast.Object ||
+				!types.Implements(tn.Type(), it) && !types.Implements(types.NewPointer(tn.Type()), it) {
+				continue
+			}
+			if len(idef.Fields) == 0 {
+				idef.Types = append(idef.Types, tn.Name())
+				continue
+			}
+			defs[tn].Interfaces = append(defs[tn].Interfaces, idef.Name)
+			e.implementFields(defs[tn], idef, it)
+		}
+		if len(idef.Fields) == 0 {
+			idef.Kind = ast.Union
+			if len(idef.Types) == 0 {
+				e.warnf("%s: skipped, no method can be used as a field and no type implements it", idef.Name)
+				delete(defs, iface)
+				delete(e.res.Models, idef.Name)
+			}
+		}
+	}
+
+	for _, tn := range names {
+		def, ok := defs[tn]
+		if !ok {
+			continue
+		}
+		if (def.Kind == ast.Object || def.Kind == ast.InputObject) && len(def.Fields) == 0 {
+			e.warnf("%s: skipped, it has no fields", def.Name)
+			delete(e.res.Models, def.Name)
+			continue
+		}
+		e.res.Schema.Definitions = append(e.res.Schema.Definitions, def)
+	}
+	for _, name := range []string{"Any", "Map", "Time"} {
+		if e.scalars[name] {
+			e.res.Schema.Definitions = append(e.res.Schema.Definitions, &ast.Definition{Kind: ast.Scalar, Name: name})
+		}
+	}
+
+	if len(e.res.Schema.Definitions) == 0 {
+		return nil, errors.New("no types found that can be mapped to the schema")
+	}
+	return e.res, nil
+}
+
+// structFields adds the fields of st to def, the fields of embedded structs included.
+func (e *extractor) structFields(def *ast.Definition, st *types.Struct, seen map[string]bool) {
+	var embedded []*types.Struct
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		name, ok := fieldName(f, tag)
+		if !ok {
+			continue
+		}
+		if f.Embedded() && !hasNameTag(tag) {
+			t := f.Type()
+			if p, isPointer := t.(*types.Pointer); isPointer {
+				t = p.Elem()
+			}
+			if s, isStruct := t.Underlying().(*types.Struct); isStruct {
+				embedded = append(embedded, s)
+				continue
+			}
+		}
+		if !f.Exported() || seen[name] {
+			continue
+		}
+		typ, err := e.typeOf(f.Type(), name)
+		if err != nil {
+			e.warnf("%s.%s: skipped, %s", def.Name, f.Name(), err)
+			continue
+		}
+		if def.Kind == ast.InputObject && e.isOutput(typ) {
+			e.warnf("%s.%s: skipped, inputs can't have fields of output type %s", def.Name, f.Name(), typ.Name())
+			continue
+		}
+		seen[name] = true
+		def.Fields = append(def.Fields, &ast.FieldDefinition{
+			Name:        name,
+			Type:        typ,
+			Description: e.docs[f.Pos()],
+		})
+		e.bindField(def.Name, name, f.Name())
+	}
+	// fields of embedded structs are promoted unless shadowed
+	for _, s := range embedded {
+		e.structFields(def, s, seen)
+	}
+}
+
+// methodFields adds the methods of it taking no arguments to def, a Get prefix is dropped from
+// their names.
+func (e *extractor) methodFields(def *ast.Definition, it *types.Interface) {
+	for i := 0; i < it.NumMethods(); i++ {
+		m := it.Method(i)
+		typ, ok := methodResult(m)
+		if !m.Exported() || !ok {
+			continue
+		}
+		name := methodFieldName(m.Name())
+		t, err := e.typeOf(typ, name)
+		if err != nil {
+			e.warnf("%s.%s: skipped, %s", def.Name, m.Name(), err)
+			continue
+		}
+		def.Fields = append(def.Fields, &ast.FieldDefinition{
+			Name:        name,
+			Type:        t,
+			Description: e.docs[m.Pos()],
+		})
+		e.bindField(def.Name, name, m.Name())
+	}
+}
+
+// implementFields adds the fields of iface obj lacks, bound to the methods implementing them.
+func (e *extractor) implementFields(obj, iface *ast.Definition, it *types.Interface) {
+	for i := 0; i < it.NumMethods(); i++ {
+		m := it.Method(i)
+		f := iface.Fields.ForName(methodFieldName(m.Name()))
+		if f == nil || obj.Fields.ForName(f.Name) != nil {
+			continue
+		}
+		obj.Fields = append(obj.Fields, &ast.FieldDefinition{Name: f.Name, Type: f.Type, Description: f.Description})
+		e.bindField(obj.Name, f.Name, m.Name())
+	}
+}
+
+func (e *extractor) enumValues(def *ast.Definition, tn *types.TypeName) {
+	consts := e.enums[tn]
+	sort.Slice(consts, func(i, j int) bool { return consts[i].Pos() < consts[j].Pos() })
+
+	entry := e.res.Models[def.Name]
+	entry.EnumValues = map[string]config.EnumValue{}
+	for _, c := range consts {
+		name := enumValueName(constant.StringVal(c.Val()))
+		if def.EnumValues.ForName(name) != nil {
+			e.warnf("%s.%s: skipped, %s is already a value of the enum", def.Name, c.Name(), name)
+			continue
+		}
+		def.EnumValues = append(def.EnumValues, &ast.EnumValueDefinition{
+			Name:        name,
+			Description: e.docs[c.Pos()],
+		})
+		entry.EnumValues[name] = config.EnumValue{Value: e.goName(c.Name())}
+	}
+	e.res.Models[def.Name] = entry
+}
+
+// typeOf returns the schema type of a Go type, pointers are nullable.
+func (e *extractor) typeOf(t types.Type, fieldName string) (*ast.Type, error) {
+	t = types.Unalias(t)
+	if p, ok := t.(*types.Pointer); ok {
+		elem := types.Unalias(p.Elem())
+		if _, isPointer := elem.(*types.Pointer); isPointer {
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+		typ, err := e.typeOf(elem, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		typ.NonNull = false
+		return typ, nil
+	}
+
+	if s, ok := t.(*types.Slice); ok {
+		if b, isBasic := s.Elem().(*types.Basic); isBasic && b.Kind() == types.Byte {
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+		elem, err := e.typeOf(s.Elem(), "")
+		if err != nil {
+			return nil, err
+		}
+		return ast.NonNullListType(elem, nil), nil
+	}
+
+	if named, ok := t.(*types.Named); ok {
+		tn := named.Obj()
+		if tn.Pkg() != nil && tn.Pkg().Path() == "time" && tn.Name() == "Time" {
+			e.scalars["Time"] = true
+			return ast.NonNullNamedType("Time", nil), nil
+		}
+		if _, ok := e.kinds[tn]; ok {
+			return ast.NonNullNamedType(tn.Name(), nil), nil
+		}
+		if _, isBasic := named.Underlying().(*types.Basic); !isBasic {
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		name := ""
+		switch u.Kind() {
+		case types.String:
+			name = "String"
+		case types.Bool:
+			name = "Boolean"
+		case types.Int, types.Int32, types.Int64:
+			name = "Int"
+		case types.Float64:
+			name = "Float"
+		default:
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+		if fieldName == "id" && name != "Boolean" && name != "Float" {
+			name = "ID"
+		}
+		return ast.NonNullNamedType(name, nil), nil
+	case *types.Map:
+		if k, ok := u.Key().(*types.Basic); ok && k.Kind() == types.String && isAny(u.Elem()) {
+			e.scalars["Map"] = true
+			return ast.NonNullNamedType("Map", nil), nil
+		}
+	case *types.Interface:
+		if u.Empty() {
+			e.scalars["Any"] = true
+			return ast.NamedType("Any", nil), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// isOutput reports whether typ names an object, interface or union.
+func (e *extractor) isOutput(typ *ast.Type) bool {
+	for tn, kind := range e.kinds {
+		if tn.Name() == typ.Name() {
+			return kind == ast.Object || kind == ast.Interface
+		}
+	}
+	return false
+}
+
+// bindField records the Go field or method of a schema field when gqlgen wouldn't find it by
+// name.
+func (e *extractor) bindField(typeName, fieldName, goName string) {
+	if strings.EqualFold(strings.ReplaceAll(fieldName, "_", ""), goName) {
+		return
+	}
+	entry := e.res.Models[typeName]
+	if entry.Fields == nil {
+		entry.Fields = map[string]config.TypeMapField{}
+	}
+	entry.Fields[fieldName] = config.TypeMapField{FieldName: goName}
+	e.res.Models[typeName] = entry
+}
+
+func (e *extractor) localType(t types.Type) *types.TypeName {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() != e.pkg.Types {
+		return nil
+	}
+	return named.Obj()
+}
+
+func (e *extractor) goName(name string) string {
+	return e.pkg.PkgPath + "." + name
+}
+
+func (e *extractor) warnf(format string, args ...any) {
+	e.res.Warnings = append(e.res.Warnings, fmt.Sprintf(format, args...))
+}
+
+// docs returns the doc comments of the declarations in files by the position of their name.
+func docs(files []*goast.File) map[token.Pos]string {
+	res := map[token.Pos]string{}
+	add := func(doc *goast.CommentGroup, names ...*goast.Ident) {
+		if doc == nil {
+			return
+		}
+		for _, name := range names {
+			res[name.Pos()] = strings.TrimSpace(doc.Text())
+		}
+	}
+	for _, file := range files {
+		goast.Inspect(file, func(n goast.Node) bool {
+			switch n := n.(type) {
+			case *goast.GenDecl:
+				if len(n.Specs) == 1 {
+					switch spec := n.Specs[0].(type) {
+					case *goast.TypeSpec:
+						add(n.Doc, spec.Name)
+					case *goast.ValueSpec:
+						add(n.Doc, spec.Names...)
+					}
+				}
+			case *goast.TypeSpec:
+				add(n.Doc, n.Name)
+			case *goast.ValueSpec:
+				add(n.Doc, n.Names...)
+			case *goast.Field:
+				add(n.Doc, n.Names...)
+			}
+			return true
+		})
+	}
+	return res
+}
+
+func fieldName(f *types.Var, tag reflect.StructTag) (string, bool) {
+	for _, key := range []string{"gqlgen", "json"} {
+		if v, ok := tag.Lookup(key); ok {
+			v, _, _ = strings.Cut(v, ",")
+			if v == "-" {
+				return "", false
+			}
+			if v != "" {
+				return v, true
+			}
+		}
+	}
+	return lowerFirstWord(f.Name()), true
+}
+
+func hasNameTag(tag reflect.StructTag) bool {
+	for _, key := range []string{"gqlgen", "json"} {
+		if v, ok := tag.Lookup(key); ok {
+			if v, _, _ = strings.Cut(v, ","); v != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// methodResult returns the result of a method usable as a field, one taking no arguments and
+// returning a value, optionally followed by an error.
+func methodResult(m *types.Func) (types.Type, bool) {
+	sig := m.Type().(*types.Signature)
+	if sig.Params().Len() != 0 {
+		return nil, false
+	}
+	res := sig.Results()
+	switch {
+	case res.Len() == 1:
+		return res.At(0).Type(), true
+	case res.Len() == 2 && res.At(1).Type().String() == "error":
+		return res.At(0).Type(), true
+	}
+	return nil, false
+}
+
+func methodFieldName(name string) string {
+	if rest, ok := strings.CutPrefix(name, "Get"); ok && rest != "" && unicode.IsUpper(rune(rest[0])) {
+		name = rest
+	}
+	return lowerFirstWord(name)
+}
+
+// lowerFirstWord lowercases the leading word of a Go name, so ID becomes id and URLPath urlPath.
+func lowerFirstWord(name string) string {
+	r := []rune(name)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// enumValueName returns the conventional name of an enum value, so in-stock becomes IN_STOCK.
+func enumValueName(value string) string {
+	var sb strings.Builder
+	for i, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+			if i > 0 && unicode.IsUpper(r) && unicode.IsLower(lastRune(value[:i])) {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(unicode.ToUpper(r))
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
+
+func lastRune(s string) rune {
+	r := []rune(s)
+	return r[len(r)-1]
+}
+
+func isString(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.String
+}
+
+func isAny(t types.Type) bool {
+	it, ok := t.Underlying().(*types.Interface)
+	return ok && it.Empty()
+}
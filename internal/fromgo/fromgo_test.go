@@ -0,0 +1,205 @@
+package fromgo
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+const shop = "github.com/99designs/gqlgen/internal/fromgo/testdata/shop"
+
+func TestExtract(t *testing.T) {
+	res, err := Extract(shop)
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	res.WriteSchema(&sb)
+	require.Equal(t, `type GiftCard {
+  code: String!
+  value: Int!
+  extra: Any
+}
+
+"""
+Item is anything sold.
+"""
+union Item = GiftCard | Product
+
+"""
+Node is an object with an ID.
+"""
+interface Node {
+  id: ID!
+}
+
+"""
+Product is sold in the shop.
+"""
+type Product implements Node {
+  id: ID!
+  """
+  Name is shown in listings.
+  """
+  name: String!
+  price_eur: Float!
+  status: Status!
+  tags: [String!]!
+  relatedProducts: [Product]!
+  metadata: Map!
+  createdAt: Time!
+  updatedAt: Time
+}
+
+input ProductInput {
+  name: String!
+  price: Int
+  sku: SKU!
+}
+
+"""
+SKU is a stock keeping unit.
+"""
+scalar SKU
+
+"""
+Status is the stock status of a product.
+"""
+enum Status {
+  """
+  StatusInStock is a product ready to ship.
+  """
+  IN_STOCK
+  OUT_OF_STOCK
+}
+
+type Timestamps {
+  createdAt: Time!
+  updatedAt: Time
+}
+
+scalar Any
+
+scalar Map
+
+scalar Time
+`, sb.String())
+
+	require.Equal(t, []string{
+		"Product.Homepage: skipped, unsupported type net/url.URL",
+		"ProductInput.Related: skipped, inputs can't have fields of output type Product",
+	}, res.Warnings)
+
+	require.Equal(t, config.StringList{shop + ".Product"}, res.Models["Product"].Model)
+	require.Equal(t, map[string]config.TypeMapField{
+		"price_eur":       {FieldName: "Price"},
+		"relatedProducts": {FieldName: "Related"},
+	}, res.Models["Product"].Fields)
+	require.Equal(t, map[string]config.EnumValue{
+		"IN_STOCK":     {Value: shop + ".StatusInStock"},
+		"OUT_OF_STOCK": {Value: shop + ".StatusOutOfStock"},
+	}, res.Models["Status"].EnumValues)
+}
+
+func TestWriteModels(t *testing.T) {
+	models := config.TypeMap{
+		"Product": {
+			Model:  config.StringList{shop + ".Product"},
+			Fields: map[string]config.TypeMapField{"price_eur": {FieldName: "Price"}},
+		},
+		"ID": {Model: config.StringList{shop + ".ID"}},
+	}
+	write := func(t *testing.T, content string) string {
+		filename := filepath.Join(t.TempDir(), "gqlgen.yml")
+		require.NoError(t, os.WriteFile(filename, []byte(content), 0o644))
+		kept, err := WriteModels(filename, models)
+		require.NoError(t, err)
+		b, err := os.ReadFile(filename)
+		require.NoError(t, err)
+		cfg, err := config.ReadConfig(strings.NewReader(string(b)))
+		require.NoError(t, err, string(b))
+		require.Equal(t, "Price", cfg.Models["Product"].Fields["price_eur"].FieldName)
+		return strings.Join(kept, ",") + "\n" + string(b)
+	}
+
+	t.Run("adds to the models section", func(t *testing.T) {
+		out := write(t, `# where the schema is
+schema:
+  - "*.graphqls"
+
+models:
+  # keep this one
+  ID:
+    model:
+      - github.com/99designs/gqlgen/graphql.ID
+`)
+		require.Equal(t, `ID
+# where the schema is
+schema:
+  - "*.graphqls"
+
+models:
+  # keep this one
+  ID:
+    model:
+      - github.com/99designs/gqlgen/graphql.ID
+  Product:
+    model:
+      - `+shop+`.Product
+    fields:
+      price_eur:
+        fieldName: Price
+`, out)
+	})
+
+	t.Run("adds the models section", func(t *testing.T) {
+		out := write(t, "schema:\n  - \"*.graphqls\"\n")
+		require.Contains(t, out, "models:\n  ID:\n")
+		require.Contains(t, out, "  Product:\n")
+	})
+
+	t.Run("fills an empty models section", func(t *testing.T) {
+		out := write(t, "models:\nschema:\n  - \"*.graphqls\"\n")
+		require.Contains(t, out, "  Product:\n")
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "roundtrip")
+	cfgFile := filepath.Join(workDir, "gqlgen.yml")
+	original, err := os.ReadFile(cfgFile)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.WriteFile(cfgFile, original, 0o644)
+		_ = os.Remove(filepath.Join(workDir, "graph", "shop.graphqls"))
+		_ = os.Remove(filepath.Join(workDir, "graph", "generated.go"))
+		t.Chdir(wd)
+	})
+	t.Chdir(workDir)
+
+	res, err := Extract(shop)
+	require.NoError(t, err)
+	var sb strings.Builder
+	res.WriteSchema(&sb)
+	require.NoError(t, os.WriteFile(filepath.Join("graph", "shop.graphqls"), []byte(sb.String()), 0o644))
+	_, err = WriteModels("gqlgen.yml", res.Models)
+	require.NoError(t, err)
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err)
+	cfg.SkipModTidy = true
+	require.NoError(t, api.Generate(cfg))
+
+	// every type is bound, there is nothing left for modelgen to generate
+	require.NoFileExists(t, filepath.Join("graph", "model", "models_gen.go"))
+	out, err := exec.Command("go", "vet", "./graph").CombinedOutput()
+	require.NoError(t, err, string(out))
+}
@@ -0,0 +1,69 @@
+// Package shop is extracted by the fromgo tests.
+package shop
+
+import (
+	"net/url"
+	"time"
+)
+
+// Status is the stock status of a product.
+type Status string
+
+const (
+	// StatusInStock is a product ready to ship.
+	StatusInStock    Status = "in-stock"
+	StatusOutOfStock Status = "OUT_OF_STOCK"
+)
+
+// Node is an object with an ID.
+type Node interface {
+	GetID() string
+}
+
+// Item is anything sold.
+type Item interface {
+	isItem()
+}
+
+type Timestamps struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// Product is sold in the shop.
+type Product struct {
+	Timestamps
+
+	ID string `json:"id"`
+	// Name is shown in listings.
+	Name     string         `json:"name"`
+	Price    float64        `json:"price_eur"`
+	Status   Status         `json:"status"`
+	Tags     []string       `json:"tags"`
+	Related  []*Product     `gqlgen:"relatedProducts" json:"related"`
+	Metadata map[string]any `json:"metadata"`
+	Homepage *url.URL       `json:"homepage"`
+	Secret   string         `json:"-"`
+	internal int
+}
+
+func (p *Product) GetID() string { return p.ID }
+func (*Product) isItem()         {}
+
+type GiftCard struct {
+	Code  string
+	Value int64
+	Extra any
+}
+
+func (GiftCard) isItem() {}
+
+// SKU is a stock keeping unit.
+type SKU string
+
+type ProductInput struct {
+	Name    string  `json:"name"`
+	Price   *int    `json:"price"`
+	SKU     SKU     `json:"sku"`
+	Related Product `json:"related"`
+}
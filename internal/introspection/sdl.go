@@ -0,0 +1,315 @@
+// Package introspection turns the result of an introspection query back into schema SDL.
+package introspection
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+type (
+	response struct {
+		Data   *data     `json:"data"`
+		Errors []any     `json:"errors"`
+		Schema *schemaTy `json:"__schema"`
+	}
+
+	data struct {
+		Schema *schemaTy `json:"__schema"`
+	}
+
+	schemaTy struct {
+		QueryType        *typeRef    `json:"queryType"`
+		MutationType     *typeRef    `json:"mutationType"`
+		SubscriptionType *typeRef    `json:"subscriptionType"`
+		Types            []fullType  `json:"types"`
+		Directives       []directive `json:"directives"`
+	}
+
+	fullType struct {
+		Kind           string       `json:"kind"`
+		Name           string       `json:"name"`
+		Description    *string      `json:"description"`
+		SpecifiedByURL *string      `json:"specifiedByURL"`
+		IsOneOf        bool         `json:"isOneOf"`
+		Fields         []field      `json:"fields"`
+		InputFields    []inputValue `json:"inputFields"`
+		Interfaces     []typeRef    `json:"interfaces"`
+		EnumValues     []enumValue  `json:"enumValues"`
+		PossibleTypes  []typeRef    `json:"possibleTypes"`
+	}
+
+	field struct {
+		Name              string       `json:"name"`
+		Description       *string      `json:"description"`
+		Args              []inputValue `json:"args"`
+		Type              typeRef      `json:"type"`
+		IsDeprecated      bool         `json:"isDeprecated"`
+		DeprecationReason *string      `json:"deprecationReason"`
+	}
+
+	inputValue struct {
+		Name              string  `json:"name"`
+		Description       *string `json:"description"`
+		Type              typeRef `json:"type"`
+		DefaultValue      *string `json:"defaultValue"`
+		IsDeprecated      bool    `json:"isDeprecated"`
+		DeprecationReason *string `json:"deprecationReason"`
+	}
+
+	enumValue struct {
+		Name              string  `json:"name"`
+		Description       *string `json:"description"`
+		IsDeprecated      bool    `json:"isDeprecated"`
+		DeprecationReason *string `json:"deprecationReason"`
+	}
+
+	typeRef struct {
+		Kind   string   `json:"kind"`
+		Name   *string  `json:"name"`
+		OfType *typeRef `json:"ofType"`
+	}
+
+	directive struct {
+		Name         string       `json:"name"`
+		Description  *string      `json:"description"`
+		Locations    []string     `json:"locations"`
+		Args         []inputValue `json:"args"`
+		IsRepeatable bool         `json:"isRepeatable"`
+	}
+)
+
+// IsIntrospection reports whether b looks like an introspection result rather than SDL.
+func IsIntrospection(b []byte) bool {
+	b = bytes.TrimSpace(b)
+	return len(b) > 0 && b[0] == '{' && bytes.Contains(b, []byte(`"__schema"`))
+}
+
+// SDL converts an introspection result, either the full response or its data, to schema SDL.
+// The types and directives built into every schema are left out.
+func SDL(b []byte) (string, error) {
+	var resp response
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return "", fmt.Errorf("unable to parse introspection: %w", err)
+	}
+	s := resp.Schema
+	if resp.Data != nil && resp.Data.Schema != nil {
+		s = resp.Data.Schema
+	}
+	if s == nil {
+		if len(resp.Errors) > 0 {
+			return "", fmt.Errorf("introspection failed: %v", resp.Errors)
+		}
+		return "", errors.New("introspection result has no __schema")
+	}
+
+	builtins := preludeNames()
+	w := &writer{}
+
+	w.printf("schema {\n")
+	for _, root := range []struct {
+		op  string
+		ref *typeRef
+	}{{"query", s.QueryType}, {"mutation", s.MutationType}, {"subscription", s.SubscriptionType}} {
+		if root.ref != nil && root.ref.Name != nil {
+			w.printf("  %s: %s\n", root.op, *root.ref.Name)
+		}
+	}
+	w.printf("}\n")
+
+	directives := append([]directive{}, s.Directives...)
+	sort.Slice(directives, func(i, j int) bool { return directives[i].Name < directives[j].Name })
+	for _, d := range directives {
+		if builtins["@"+d.Name] {
+			continue
+		}
+		w.printf("\n")
+		w.description("", d.Description)
+		w.printf("directive @%s", d.Name)
+		w.arguments(d.Args)
+		if d.IsRepeatable {
+			w.printf(" repeatable")
+		}
+		w.printf(" on %s\n", strings.Join(d.Locations, " | "))
+	}
+
+	types := append([]fullType{}, s.Types...)
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	for _, t := range types {
+		if builtins[t.Name] || strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+		w.printf("\n")
+		w.description("", t.Description)
+		switch t.Kind {
+		case "SCALAR":
+			w.printf("scalar %s", t.Name)
+			if t.SpecifiedByURL != nil {
+				w.printf(" @specifiedBy(url: %s)", quote(*t.SpecifiedByURL))
+			}
+			w.printf("\n")
+		case "OBJECT", "INTERFACE":
+			keyword := "type"
+			if t.Kind == "INTERFACE" {
+				keyword = "interface"
+			}
+			w.printf("%s %s", keyword, t.Name)
+			for i, iface := range t.Interfaces {
+				if i == 0 {
+					w.printf(" implements %s", iface.String())
+				} else {
+					w.printf(" & %s", iface.String())
+				}
+			}
+			w.printf(" {\n")
+			for _, f := range t.Fields {
+				w.description("  ", f.Description)
+				w.printf("  %s", f.Name)
+				w.arguments(f.Args)
+				w.printf(": %s", f.Type.String())
+				w.deprecated(f.IsDeprecated, f.DeprecationReason)
+				w.printf("\n")
+			}
+			w.printf("}\n")
+		case "UNION":
+			var members []string
+			for _, p := range t.PossibleTypes {
+				members = append(members, p.String())
+			}
+			w.printf("union %s = %s\n", t.Name, strings.Join(members, " | "))
+		case "ENUM":
+			w.printf("enum %s {\n", t.Name)
+			for _, v := range t.EnumValues {
+				w.description("  ", v.Description)
+				w.printf("  %s", v.Name)
+				w.deprecated(v.IsDeprecated, v.DeprecationReason)
+				w.printf("\n")
+			}
+			w.printf("}\n")
+		case "INPUT_OBJECT":
+			w.printf("input %s", t.Name)
+			if t.IsOneOf {
+				w.printf(" @oneOf")
+			}
+			w.printf(" {\n")
+			for _, f := range t.InputFields {
+				w.description("  ", f.Description)
+				w.printf("  ")
+				w.inputValue(f)
+				w.printf("\n")
+			}
+			w.printf("}\n")
+		default:
+			return "", fmt.Errorf("unknown kind %s for type %s", t.Kind, t.Name)
+		}
+	}
+
+	return w.String(), nil
+}
+
+// preludeNames returns the types and directives, prefixed with @, that gqlparser adds to every
+// schema.
+func preludeNames() map[string]bool {
+	names := map[string]bool{}
+	doc, err := parser.ParseSchema(validator.Prelude)
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range doc.Definitions {
+		names[d.Name] = true
+	}
+	for _, d := range doc.Directives {
+		names["@"+d.Name] = true
+	}
+	return names
+}
+
+func (t typeRef) String() string {
+	switch t.Kind {
+	case "NON_NULL":
+		return t.OfType.String() + "!"
+	case "LIST":
+		return "[" + t.OfType.String() + "]"
+	default:
+		if t.Name == nil {
+			return ""
+		}
+		return *t.Name
+	}
+}
+
+type writer struct {
+	strings.Builder
+}
+
+func (w *writer) printf(format string, args ...any) {
+	fmt.Fprintf(w, format, args...)
+}
+
+func (w *writer) description(indent string, description *string) {
+	if description == nil || *description == "" {
+		return
+	}
+	w.printf("%s\"\"\"\n", indent)
+	for _, line := range strings.Split(strings.ReplaceAll(*description, `"""`, `\"""`), "\n") {
+		w.printf("%s%s\n", indent, line)
+	}
+	w.printf("%s\"\"\"\n", indent)
+}
+
+func (w *writer) arguments(args []inputValue) {
+	if len(args) == 0 {
+		return
+	}
+	w.printf("(")
+	for i, arg := range args {
+		if i > 0 {
+			w.printf(", ")
+		}
+		if arg.Description != nil && *arg.Description != "" {
+			w.printf("%s ", quote(*arg.Description))
+		}
+		w.inputValue(arg)
+	}
+	w.printf(")")
+}
+
+func (w *writer) inputValue(v inputValue) {
+	w.printf("%s: %s", v.Name, v.Type.String())
+	if v.DefaultValue != nil {
+		w.printf(" = %s", *v.DefaultValue)
+	}
+	w.deprecated(v.IsDeprecated, v.DeprecationReason)
+}
+
+func (w *writer) deprecated(isDeprecated bool, reason *string) {
+	if !isDeprecated {
+		return
+	}
+	if reason == nil || *reason == "" {
+		w.printf(" @deprecated")
+		return
+	}
+	w.printf(" @deprecated(reason: %s)", quote(*reason))
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// Source wraps the SDL of an introspection result in a source named name.
+func Source(name string, b []byte) (*ast.Source, error) {
+	sdl, err := SDL(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &ast.Source{Name: name, Input: sdl}, nil
+}
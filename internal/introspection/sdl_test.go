@@ -0,0 +1,190 @@
+package introspection
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql/introspection"
+)
+
+const schema = `directive @cache(
+  "seconds"
+  maxAge: Int = 60
+) repeatable on FIELD_DEFINITION | OBJECT
+
+"""
+A user of the
+application
+"""
+type User implements Node {
+  id: ID!
+  name(format: Format = SHORT, "upper case" upper: Boolean): String
+  nickname: String @deprecated(reason: "use name")
+  roles: [Role!]!
+}
+
+interface Node {
+  id: ID!
+}
+
+union Result = User
+
+enum Role {
+  ADMIN
+  GUEST @deprecated
+}
+
+enum Format {
+  SHORT
+  LONG
+}
+
+input Filter @oneOf {
+  id: ID
+  name: String
+}
+
+scalar Time @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")
+
+type Query {
+  node(filter: Filter): Node
+  search: [Result]
+  now: Time!
+}
+
+type Mutation {
+  noop: Boolean
+}
+`
+
+func TestSDL(t *testing.T) {
+	want, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphqls", Input: schema})
+	require.NoError(t, err)
+
+	result := introspect(t, want)
+
+	t.Run("round trips", func(t *testing.T) {
+		sdl, err := SDL(result)
+		require.NoError(t, err)
+
+		got, err := gqlparser.LoadSchema(&ast.Source{Name: "introspection", Input: sdl})
+		require.NoError(t, err, sdl)
+		require.Equal(t, format(want), format(got))
+	})
+
+	t.Run("accepts the data object", func(t *testing.T) {
+		var resp struct{ Data json.RawMessage }
+		require.NoError(t, json.Unmarshal(result, &resp))
+		require.True(t, IsIntrospection(resp.Data))
+
+		sdl, err := SDL(resp.Data)
+		require.NoError(t, err)
+		require.Contains(t, sdl, "type User implements Node {")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := SDL([]byte(`{"errors":[{"message":"introspection disabled"}]}`))
+		require.ErrorContains(t, err, "introspection disabled")
+
+		_, err = SDL([]byte(`{}`))
+		require.EqualError(t, err, "introspection result has no __schema")
+
+		_, err = SDL([]byte(`type Query`))
+		require.ErrorContains(t, err, "unable to parse introspection")
+		require.False(t, IsIntrospection([]byte(`type Query { a: String }`)))
+	})
+}
+
+// introspect builds the introspection result the way a gqlgen server would answer it.
+func introspect(t *testing.T, schema *ast.Schema) []byte {
+	t.Helper()
+	s := introspection.WrapSchema(schema)
+
+	res := &schemaTy{
+		QueryType:        ref(s.QueryType()),
+		MutationType:     ref(s.MutationType()),
+		SubscriptionType: ref(s.SubscriptionType()),
+	}
+	for _, typ := range s.Types() {
+		ft := fullType{
+			Kind:           typ.Kind(),
+			Name:           *typ.Name(),
+			Description:    typ.Description(),
+			SpecifiedByURL: typ.SpecifiedByURL(),
+			IsOneOf:        typ.IsOneOf(),
+			InputFields:    inputValues(typ.InputFields()),
+		}
+		for _, f := range typ.Fields(true) {
+			ft.Fields = append(ft.Fields, field{
+				Name:              f.Name,
+				Description:       f.Description(),
+				Args:              inputValues(f.Args),
+				Type:              *ref(f.Type),
+				IsDeprecated:      f.IsDeprecated(),
+				DeprecationReason: f.DeprecationReason(),
+			})
+		}
+		for _, i := range typ.Interfaces() {
+			ft.Interfaces = append(ft.Interfaces, *ref(&i))
+		}
+		for _, p := range typ.PossibleTypes() {
+			ft.PossibleTypes = append(ft.PossibleTypes, *ref(&p))
+		}
+		for _, v := range typ.EnumValues(true) {
+			ft.EnumValues = append(ft.EnumValues, enumValue{
+				Name:              v.Name,
+				Description:       v.Description(),
+				IsDeprecated:      v.IsDeprecated(),
+				DeprecationReason: v.DeprecationReason(),
+			})
+		}
+		res.Types = append(res.Types, ft)
+	}
+	for _, d := range s.Directives() {
+		res.Directives = append(res.Directives, directive{
+			Name:         d.Name,
+			Description:  d.Description(),
+			Locations:    d.Locations,
+			Args:         inputValues(d.Args),
+			IsRepeatable: d.IsRepeatable,
+		})
+	}
+
+	b, err := json.Marshal(response{Data: &data{Schema: res}})
+	require.NoError(t, err)
+	return b
+}
+
+func ref(t *introspection.Type) *typeRef {
+	if t == nil {
+		return nil
+	}
+	return &typeRef{Kind: t.Kind(), Name: t.Name(), OfType: ref(t.OfType())}
+}
+
+func inputValues(values []introspection.InputValue) []inputValue {
+	var res []inputValue
+	for _, v := range values {
+		res = append(res, inputValue{
+			Name:              v.Name,
+			Description:       v.Description(),
+			Type:              *ref(v.Type),
+			DefaultValue:      v.DefaultValue,
+			IsDeprecated:      v.IsDeprecated(),
+			DeprecationReason: v.DeprecationReason(),
+		})
+	}
+	return res
+}
+
+func format(s *ast.Schema) string {
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatSchema(s)
+	return buf.String()
+}
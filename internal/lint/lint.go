@@ -0,0 +1,151 @@
+// Package lint checks a schema against configurable style and design rules.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+	Info    Severity = "info"
+	Off     Severity = "off"
+)
+
+func (s Severity) valid() bool {
+	switch s {
+	case Error, Warning, Info, Off:
+		return true
+	}
+	return false
+}
+
+// Diagnostic is a single rule violation.
+type Diagnostic struct {
+	Rule     string
+	Severity Severity
+	Message  string
+	// Coordinate is the schema coordinate the diagnostic is about, e.g. User, User.name or
+	// User.name(format:).
+	Coordinate string
+	// Position is where the offending definition is declared, it is nil for definitions that
+	// were not parsed from a source.
+	Position *ast.Position
+}
+
+func (d Diagnostic) String() string {
+	location := "<schema>"
+	if d.Position != nil && d.Position.Src != nil {
+		location = fmt.Sprintf("%s:%d:%d", d.Position.Src.Name, d.Position.Line, d.Position.Column)
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", location, d.Severity, d.Message, d.Rule)
+}
+
+// Rule is a check run against the schema.
+type Rule struct {
+	Name        string
+	Description string
+	// Severity is used when the rule is not configured in the lint section of gqlgen.yml.
+	Severity Severity
+
+	check func(r *reporter, schema *ast.Schema)
+}
+
+// Run checks the schema against every rule that is not turned off in cfg and returns the
+// diagnostics ordered by position.
+func Run(schema *ast.Schema, cfg config.LintConfig) ([]Diagnostic, error) {
+	for name, rc := range cfg.Rules {
+		if ruleByName(name) == nil {
+			return nil, fmt.Errorf("lint.rules: unknown rule %s", name)
+		}
+		if rc.Severity != "" && !Severity(rc.Severity).valid() {
+			return nil, fmt.Errorf(
+				"lint.rules.%s: unknown severity %s, expected error, warning, info or off",
+				name, rc.Severity,
+			)
+		}
+	}
+
+	var diagnostics []Diagnostic
+	for _, rule := range Rules {
+		r := &reporter{rule: rule, severity: rule.Severity}
+		if rc, ok := cfg.Rules[rule.Name]; ok {
+			if rc.Severity != "" {
+				r.severity = Severity(rc.Severity)
+			}
+			r.ignore = rc.Ignore
+		}
+		if r.severity == Off {
+			continue
+		}
+		rule.check(r, schema)
+		diagnostics = append(diagnostics, r.diagnostics...)
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i].Position, diagnostics[j].Position
+		if a == nil || b == nil || a.Src == nil || b.Src == nil {
+			return a != nil && b == nil
+		}
+		if a.Src.Name != b.Src.Name {
+			return a.Src.Name < b.Src.Name
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return diagnostics, nil
+}
+
+// Count returns the number of diagnostics with the given severity.
+func Count(diagnostics []Diagnostic, severity Severity) int {
+	n := 0
+	for _, d := range diagnostics {
+		if d.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+func ruleByName(name string) *Rule {
+	for _, rule := range Rules {
+		if rule.Name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+type reporter struct {
+	rule        *Rule
+	severity    Severity
+	ignore      config.StringList
+	diagnostics []Diagnostic
+}
+
+func (r *reporter) report(pos *ast.Position, coordinate, format string, args ...any) {
+	for _, ignored := range r.ignore {
+		if coordinate == ignored ||
+			strings.HasPrefix(coordinate, ignored+".") ||
+			strings.HasPrefix(coordinate, ignored+"(") {
+			return
+		}
+	}
+	r.diagnostics = append(r.diagnostics, Diagnostic{
+		Rule:       r.rule.Name,
+		Severity:   r.severity,
+		Message:    fmt.Sprintf(format, args...),
+		Coordinate: coordinate,
+		Position:   pos,
+	})
+}
@@ -0,0 +1,118 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func loadSchema(t *testing.T) *ast.Schema {
+	t.Helper()
+	b, err := os.ReadFile("testdata/schema.graphqls")
+	require.NoError(t, err)
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "testdata/schema.graphqls", Input: string(b)})
+	require.NoError(t, err)
+	return schema
+}
+
+func lines(diagnostics []Diagnostic) []string {
+	var res []string
+	for _, d := range diagnostics {
+		res = append(res, d.String())
+	}
+	return res
+}
+
+func TestRun(t *testing.T) {
+	schema := loadSchema(t)
+
+	t.Run("default rules", func(t *testing.T) {
+		diagnostics, err := Run(schema, config.LintConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"testdata/schema.graphqls:2:3: warning: field Query.user_by_id should be camelCase (field-camel-case)",
+			"testdata/schema.graphqls:2:14: warning: argument ID on Query.user_by_id should be camelCase (field-camel-case)",
+			"testdata/schema.graphqls:4:3: warning: connection field Query.posts should take first and after, or last and before arguments (relay-connection)",
+			"testdata/schema.graphqls:5:19: warning: Query.legacy is deprecated without a reason (deprecated-reason-required)",
+			"testdata/schema.graphqls:9:6: warning: type user should be PascalCase (type-pascal-case)",
+			"testdata/schema.graphqls:17:3: warning: enum value Role.superUser should be SCREAMING_SNAKE_CASE (enum-value-screaming-case)",
+			"testdata/schema.graphqls:30:6: warning: connection PostConnection should have a pageInfo field returning PageInfo! (relay-connection)",
+			"testdata/schema.graphqls:34:6: warning: edge PostEdge should have a node field that is not a list (relay-connection)",
+			"testdata/schema.graphqls:34:6: warning: edge PostEdge should have a non-null cursor field returning a scalar (relay-connection)",
+			"testdata/schema.graphqls:38:6: warning: PageInfo should have a hasPreviousPage field returning Boolean! (relay-connection)",
+			"testdata/schema.graphqls:45:7: warning: type NewUser is not used by any root operation type (unused-type)",
+			"testdata/schema.graphqls:49:6: warning: type Orphan is not used by any root operation type (unused-type)",
+		}, lines(diagnostics))
+	})
+
+	t.Run("configured rules", func(t *testing.T) {
+		diagnostics, err := Run(schema, config.LintConfig{Rules: map[string]config.LintRuleConfig{
+			"field-camel-case":           {Severity: "error", Ignore: config.StringList{"Query.user_by_id(ID:)"}},
+			"relay-connection":           {Severity: "off"},
+			"unused-type":                {Ignore: config.StringList{"Orphan"}},
+			"type-pascal-case":           {Ignore: config.StringList{"user"}},
+			"enum-value-screaming-case":  {Severity: "info"},
+			"deprecated-reason-required": {Ignore: config.StringList{"Query"}},
+			"description-required":       {Severity: "warning", Ignore: config.StringList{"Query", "UserConnection", "UserEdge", "PostConnection", "PostEdge", "PageInfo", "NewUser", "Orphan", "Role"}},
+			"input-name":                 {Severity: "error"},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"testdata/schema.graphqls:2:3: error: field Query.user_by_id should be camelCase (field-camel-case)",
+			"testdata/schema.graphqls:10:3: warning: field user.id has no description (description-required)",
+			"testdata/schema.graphqls:11:3: warning: field user.role has no description (description-required)",
+			"testdata/schema.graphqls:12:3: warning: field user.nickname has no description (description-required)",
+			"testdata/schema.graphqls:17:3: info: enum value Role.superUser should be SCREAMING_SNAKE_CASE (enum-value-screaming-case)",
+			"testdata/schema.graphqls:45:7: error: input NewUser should be named NewUserInput (input-name)",
+			"testdata/schema.graphqls:45:7: warning: type NewUser is not used by any root operation type (unused-type)",
+		}, lines(diagnostics))
+		require.Equal(t, 2, Count(diagnostics, Error))
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		_, err := Run(schema, config.LintConfig{Rules: map[string]config.LintRuleConfig{"nope": {}}})
+		require.EqualError(t, err, "lint.rules: unknown rule nope")
+	})
+
+	t.Run("unknown severity", func(t *testing.T) {
+		_, err := Run(schema, config.LintConfig{Rules: map[string]config.LintRuleConfig{
+			"unused-type": {Severity: "fatal"},
+		}})
+		require.EqualError(t, err, "lint.rules.unused-type: unknown severity fatal, expected error, warning, info or off")
+	})
+}
+
+func TestWriteSARIF(t *testing.T) {
+	diagnostics, err := Run(loadSchema(t), config.LintConfig{Rules: map[string]config.LintRuleConfig{
+		"unused-type": {Severity: "error", Ignore: config.StringList{"NewUser"}},
+	}})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSARIF(&buf, diagnostics))
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, len(Rules))
+	require.Len(t, log.Runs[0].Results, len(diagnostics))
+
+	last := log.Runs[0].Results[len(diagnostics)-1]
+	require.Equal(t, sarifResult{
+		RuleID:  "unused-type",
+		Level:   "error",
+		Message: sarifMessage{Text: "type Orphan is not used by any root operation type"},
+		Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: "testdata/schema.graphqls"},
+			Region:           sarifRegion{StartLine: 49, StartColumn: 6},
+		}}},
+	}, last)
+}
@@ -0,0 +1,313 @@
+package lint
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Rules are all the rules known to the linter, in the order they run.
+var Rules = []*Rule{
+	{
+		Name:        "type-pascal-case",
+		Description: "Type names are PascalCase.",
+		Severity:    Warning,
+		check:       checkTypeNames,
+	},
+	{
+		Name:        "field-camel-case",
+		Description: "Field and argument names are camelCase.",
+		Severity:    Warning,
+		check:       checkFieldNames,
+	},
+	{
+		Name:        "enum-value-screaming-case",
+		Description: "Enum values are SCREAMING_SNAKE_CASE.",
+		Severity:    Warning,
+		check:       checkEnumValues,
+	},
+	{
+		Name:        "description-required",
+		Description: "Types and fields have a description.",
+		Severity:    Off,
+		check:       checkDescriptions,
+	},
+	{
+		Name:        "deprecated-reason-required",
+		Description: "Every @deprecated directive gives a reason.",
+		Severity:    Warning,
+		check:       checkDeprecationReasons,
+	},
+	{
+		Name:        "relay-connection",
+		Description: "Types named *Connection follow the Relay cursor connections specification.",
+		Severity:    Warning,
+		check:       checkRelayConnections,
+	},
+	{
+		Name:        "input-name",
+		Description: "Input type names end with Input.",
+		Severity:    Off,
+		check:       checkInputNames,
+	},
+	{
+		Name:        "unused-type",
+		Description: "Every type can be reached from a root operation type.",
+		Severity:    Warning,
+		check:       checkUnusedTypes,
+	},
+}
+
+var (
+	pascalCase    = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	camelCase     = regexp.MustCompile(`^_*[a-z][A-Za-z0-9]*$`)
+	screamingCase = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+)
+
+func checkTypeNames(r *reporter, schema *ast.Schema) {
+	for _, def := range definitions(schema) {
+		if !pascalCase.MatchString(def.Name) {
+			r.report(def.Position, def.Name, "type %s should be PascalCase", def.Name)
+		}
+	}
+}
+
+func checkFieldNames(r *reporter, schema *ast.Schema) {
+	for _, def := range definitions(schema) {
+		for _, f := range fields(def) {
+			coordinate := def.Name + "." + f.Name
+			if !camelCase.MatchString(f.Name) {
+				r.report(f.Position, coordinate, "field %s should be camelCase", coordinate)
+			}
+			for _, arg := range f.Arguments {
+				if !camelCase.MatchString(arg.Name) {
+					r.report(arg.Position, argCoordinate(coordinate, arg.Name),
+						"argument %s on %s should be camelCase", arg.Name, coordinate)
+				}
+			}
+		}
+	}
+}
+
+func checkEnumValues(r *reporter, schema *ast.Schema) {
+	for _, def := range definitions(schema) {
+		for _, v := range def.EnumValues {
+			if !screamingCase.MatchString(v.Name) {
+				coordinate := def.Name + "." + v.Name
+				r.report(v.Position, coordinate, "enum value %s should be SCREAMING_SNAKE_CASE", coordinate)
+			}
+		}
+	}
+}
+
+func checkDescriptions(r *reporter, schema *ast.Schema) {
+	for _, def := range definitions(schema) {
+		if def.Description == "" && !isRootType(schema, def) {
+			r.report(def.Position, def.Name, "type %s has no description", def.Name)
+		}
+		for _, f := range fields(def) {
+			if f.Description == "" {
+				coordinate := def.Name + "." + f.Name
+				r.report(f.Position, coordinate, "field %s has no description", coordinate)
+			}
+		}
+	}
+}
+
+func checkDeprecationReasons(r *reporter, schema *ast.Schema) {
+	check := func(directives ast.DirectiveList, coordinate string) {
+		d := directives.ForName("deprecated")
+		if d == nil {
+			return
+		}
+		if reason := d.Arguments.ForName("reason"); reason == nil || strings.TrimSpace(reason.Value.Raw) == "" {
+			r.report(d.Position, coordinate, "%s is deprecated without a reason", coordinate)
+		}
+	}
+
+	for _, def := range definitions(schema) {
+		for _, f := range fields(def) {
+			coordinate := def.Name + "." + f.Name
+			check(f.Directives, coordinate)
+			for _, arg := range f.Arguments {
+				check(arg.Directives, argCoordinate(coordinate, arg.Name))
+			}
+		}
+		for _, v := range def.EnumValues {
+			check(v.Directives, def.Name+"."+v.Name)
+		}
+	}
+}
+
+func checkRelayConnections(r *reporter, schema *ast.Schema) {
+	isConnection := func(t *ast.Type) bool {
+		def := schema.Types[t.Name()]
+		return def != nil && def.Kind == ast.Object && t.Elem == nil &&
+			strings.HasSuffix(def.Name, "Connection") && def.Name != "Connection"
+	}
+
+	checkedEdges := map[string]bool{}
+	checkedPageInfo := false
+
+	for _, def := range definitions(schema) {
+		if def.Kind != ast.Object {
+			continue
+		}
+
+		for _, f := range def.Fields {
+			if strings.HasPrefix(f.Name, "__") || !isConnection(f.Type) {
+				continue
+			}
+			coordinate := def.Name + "." + f.Name
+			args := f.Arguments
+			forward := args.ForName("first") != nil && args.ForName("after") != nil
+			backward := args.ForName("last") != nil && args.ForName("before") != nil
+			if !forward && !backward {
+				r.report(f.Position, coordinate,
+					"connection field %s should take first and after, or last and before arguments", coordinate)
+			}
+		}
+
+		if !isConnection(ast.NamedType(def.Name, nil)) {
+			continue
+		}
+
+		edges := def.Fields.ForName("edges")
+		if edges == nil || edges.Type.Elem == nil {
+			r.report(def.Position, def.Name, "connection %s should have an edges field returning a list", def.Name)
+		} else if edge := schema.Types[edges.Type.Elem.Name()]; edge == nil || edge.Kind != ast.Object {
+			r.report(edges.Position, def.Name+".edges", "edges of %s should be a list of objects", def.Name)
+		} else if !checkedEdges[edge.Name] {
+			checkedEdges[edge.Name] = true
+			if node := edge.Fields.ForName("node"); node == nil || node.Type.Elem != nil {
+				r.report(edge.Position, edge.Name, "edge %s should have a node field that is not a list", edge.Name)
+			}
+			if cursor := edge.Fields.ForName("cursor"); cursor == nil || !isNonNullScalar(schema, cursor.Type) {
+				r.report(edge.Position, edge.Name, "edge %s should have a non-null cursor field returning a scalar", edge.Name)
+			}
+		}
+
+		pageInfo := def.Fields.ForName("pageInfo")
+		if pageInfo == nil || pageInfo.Type.String() != "PageInfo!" {
+			r.report(def.Position, def.Name, "connection %s should have a pageInfo field returning PageInfo!", def.Name)
+		} else if !checkedPageInfo {
+			checkedPageInfo = true
+			checkPageInfo(r, schema)
+		}
+	}
+}
+
+func checkPageInfo(r *reporter, schema *ast.Schema) {
+	def := schema.Types["PageInfo"]
+	if def == nil || def.Kind != ast.Object {
+		return
+	}
+	for _, name := range []string{"hasNextPage", "hasPreviousPage"} {
+		if f := def.Fields.ForName(name); f == nil || f.Type.String() != "Boolean!" {
+			r.report(def.Position, "PageInfo", "PageInfo should have a %s field returning Boolean!", name)
+		}
+	}
+	for _, name := range []string{"startCursor", "endCursor"} {
+		if f := def.Fields.ForName(name); f == nil || f.Type.Elem != nil || !isScalar(schema, f.Type) {
+			r.report(def.Position, "PageInfo", "PageInfo should have a %s field returning a scalar", name)
+		}
+	}
+}
+
+func checkInputNames(r *reporter, schema *ast.Schema) {
+	for _, def := range definitions(schema) {
+		if def.Kind == ast.InputObject && !strings.HasSuffix(def.Name, "Input") {
+			r.report(def.Position, def.Name, "input %s should be named %sInput", def.Name, def.Name)
+		}
+	}
+}
+
+func checkUnusedTypes(r *reporter, schema *ast.Schema) {
+	used := map[string]bool{}
+	var visit func(name string)
+	visitType := func(t *ast.Type) {
+		visit(t.Name())
+	}
+	visit = func(name string) {
+		def := schema.Types[name]
+		if def == nil || used[name] {
+			return
+		}
+		used[name] = true
+		for _, f := range def.Fields {
+			visitType(f.Type)
+			for _, arg := range f.Arguments {
+				visitType(arg.Type)
+			}
+		}
+		for _, name := range def.Interfaces {
+			visit(name)
+		}
+		for _, name := range def.Types {
+			visit(name)
+		}
+		// implementations of a reachable interface can be selected with fragments
+		for _, impl := range schema.PossibleTypes[name] {
+			visit(impl.Name)
+		}
+	}
+
+	for _, root := range []*ast.Definition{schema.Query, schema.Mutation, schema.Subscription} {
+		if root != nil {
+			visit(root.Name)
+		}
+	}
+	for _, d := range schema.Directives {
+		for _, arg := range d.Arguments {
+			visitType(arg.Type)
+		}
+	}
+
+	for _, def := range definitions(schema) {
+		if !used[def.Name] {
+			r.report(def.Position, def.Name, "type %s is not used by any root operation type", def.Name)
+		}
+	}
+}
+
+// definitions returns the types declared in the schema sources, ordered by name.
+func definitions(schema *ast.Schema) []*ast.Definition {
+	var defs []*ast.Definition
+	for _, def := range schema.Types {
+		if !def.BuiltIn {
+			defs = append(defs, def)
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// fields returns the fields of objects, interfaces and inputs, leaving out introspection fields.
+func fields(def *ast.Definition) ast.FieldList {
+	var res ast.FieldList
+	for _, f := range def.Fields {
+		if !strings.HasPrefix(f.Name, "__") {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+func argCoordinate(field, arg string) string {
+	return field + "(" + arg + ":)"
+}
+
+func isRootType(schema *ast.Schema, def *ast.Definition) bool {
+	return def == schema.Query || def == schema.Mutation || def == schema.Subscription
+}
+
+func isScalar(schema *ast.Schema, t *ast.Type) bool {
+	def := schema.Types[t.Name()]
+	return def != nil && def.Kind == ast.Scalar
+}
+
+func isNonNullScalar(schema *ast.Schema, t *ast.Type) bool {
+	return t.NonNull && t.Elem == nil && isScalar(schema, t)
+}
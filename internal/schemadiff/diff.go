@@ -0,0 +1,428 @@
+// Package schemadiff compares two schemas and classifies the changes by how they affect
+// existing clients.
+package schemadiff
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Level is how a change affects existing clients.
+type Level string
+
+const (
+	// Breaking changes make existing operations invalid or change their results.
+	Breaking Level = "BREAKING"
+	// Dangerous changes keep existing operations valid but may change how clients behave, e.g.
+	// a new enum value reaching a client that switches over the known values.
+	Dangerous Level = "DANGEROUS"
+	// Safe changes cannot affect existing clients.
+	Safe Level = "SAFE"
+)
+
+func (l Level) order() int {
+	switch l {
+	case Breaking:
+		return 0
+	case Dangerous:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Change is a single difference between two schemas. Type names the kind of change, matching
+// the names graphql-js reports where it has an equivalent.
+type Change struct {
+	Level   Level  `json:"level"`
+	Type    string `json:"type"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// Diff returns the changes from oldSchema to newSchema, breaking changes first. Types and
+// directives built into every schema are ignored.
+func Diff(oldSchema, newSchema *ast.Schema) []Change {
+	d := &differ{}
+
+	d.rootType("query", oldSchema.Query, newSchema.Query)
+	d.rootType("mutation", oldSchema.Mutation, newSchema.Mutation)
+	d.rootType("subscription", oldSchema.Subscription, newSchema.Subscription)
+
+	for _, name := range sortedKeys(oldSchema.Types) {
+		oldDef := oldSchema.Types[name]
+		if oldDef.BuiltIn {
+			continue
+		}
+		newDef, ok := newSchema.Types[name]
+		if !ok {
+			d.add(Breaking, "TYPE_REMOVED", name, "%s was removed.", name)
+			continue
+		}
+		d.definition(oldDef, newDef)
+	}
+	for _, name := range sortedKeys(newSchema.Types) {
+		if newSchema.Types[name].BuiltIn {
+			continue
+		}
+		if _, ok := oldSchema.Types[name]; !ok {
+			d.add(Safe, "TYPE_ADDED", name, "%s was added.", name)
+		}
+	}
+
+	for _, name := range sortedKeys(oldSchema.Directives) {
+		oldDir := oldSchema.Directives[name]
+		if isBuiltInDirective(oldDir) {
+			continue
+		}
+		newDir, ok := newSchema.Directives[name]
+		if !ok {
+			d.add(Breaking, "DIRECTIVE_REMOVED", "@"+name, "@%s was removed.", name)
+			continue
+		}
+		d.directive(oldDir, newDir)
+	}
+	for _, name := range sortedKeys(newSchema.Directives) {
+		if isBuiltInDirective(newSchema.Directives[name]) {
+			continue
+		}
+		if _, ok := oldSchema.Directives[name]; !ok {
+			d.add(Safe, "DIRECTIVE_ADDED", "@"+name, "@%s was added.", name)
+		}
+	}
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Level.order() < d.changes[j].Level.order()
+	})
+	return d.changes
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(level Level, typ, path, format string, args ...any) {
+	d.changes = append(d.changes, Change{
+		Level:   level,
+		Type:    typ,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) rootType(op string, oldDef, newDef *ast.Definition) {
+	switch {
+	case oldDef == nil:
+		return
+	case newDef == nil:
+		d.add(Breaking, "ROOT_TYPE_REMOVED", oldDef.Name, "The %s root type was removed.", op)
+	case oldDef.Name != newDef.Name:
+		d.add(Breaking, "ROOT_TYPE_CHANGED", oldDef.Name,
+			"The %s root type changed from %s to %s.", op, oldDef.Name, newDef.Name)
+	}
+}
+
+func (d *differ) definition(oldDef, newDef *ast.Definition) {
+	name := oldDef.Name
+	if oldDef.Kind != newDef.Kind {
+		d.add(Breaking, "TYPE_CHANGED_KIND", name,
+			"%s changed from %s to %s.", name, kindName(oldDef.Kind), kindName(newDef.Kind))
+		return
+	}
+	if oldDef.Description != newDef.Description {
+		d.add(Safe, "DESCRIPTION_CHANGED", name, "Description of %s changed.", name)
+	}
+
+	switch oldDef.Kind {
+	case ast.Object, ast.Interface:
+		d.interfaces(oldDef, newDef)
+		d.outputFields(oldDef, newDef)
+	case ast.InputObject:
+		d.inputFields(oldDef, newDef)
+	case ast.Union:
+		d.unionMembers(oldDef, newDef)
+	case ast.Enum:
+		d.enumValues(oldDef, newDef)
+	}
+}
+
+func (d *differ) interfaces(oldDef, newDef *ast.Definition) {
+	for _, iface := range oldDef.Interfaces {
+		if !slices.Contains(newDef.Interfaces, iface) {
+			d.add(Breaking, "IMPLEMENTED_INTERFACE_REMOVED", oldDef.Name,
+				"%s no longer implements %s.", oldDef.Name, iface)
+		}
+	}
+	for _, iface := range newDef.Interfaces {
+		if !slices.Contains(oldDef.Interfaces, iface) {
+			d.add(Dangerous, "IMPLEMENTED_INTERFACE_ADDED", oldDef.Name,
+				"%s now implements %s.", oldDef.Name, iface)
+		}
+	}
+}
+
+func (d *differ) outputFields(oldDef, newDef *ast.Definition) {
+	for _, oldField := range oldDef.Fields {
+		if strings.HasPrefix(oldField.Name, "__") {
+			continue
+		}
+		path := oldDef.Name + "." + oldField.Name
+		newField := newDef.Fields.ForName(oldField.Name)
+		if newField == nil {
+			if oldField.Directives.ForName("deprecated") != nil {
+				d.add(Breaking, "FIELD_REMOVED", path, "Deprecated field %s was removed.", path)
+			} else {
+				d.add(Breaking, "FIELD_REMOVED", path, "%s was removed.", path)
+			}
+			continue
+		}
+
+		if oldField.Type.String() != newField.Type.String() {
+			if safeOutputChange(oldField.Type, newField.Type) {
+				d.add(Safe, "FIELD_TYPE_CHANGED", path,
+					"%s changed type from %s to %s.", path, oldField.Type, newField.Type)
+			} else {
+				d.add(Breaking, "FIELD_CHANGED_KIND", path,
+					"%s changed type from %s to %s.", path, oldField.Type, newField.Type)
+			}
+		}
+		if oldField.Description != newField.Description {
+			d.add(Safe, "DESCRIPTION_CHANGED", path, "Description of %s changed.", path)
+		}
+		d.deprecation(path, oldField.Directives, newField.Directives)
+		d.arguments(path, oldField.Arguments, newField.Arguments, "ARG")
+	}
+	for _, newField := range newDef.Fields {
+		if strings.HasPrefix(newField.Name, "__") {
+			continue
+		}
+		if oldDef.Fields.ForName(newField.Name) == nil {
+			path := oldDef.Name + "." + newField.Name
+			d.add(Safe, "FIELD_ADDED", path, "%s was added.", path)
+		}
+	}
+}
+
+func (d *differ) inputFields(oldDef, newDef *ast.Definition) {
+	for _, oldField := range oldDef.Fields {
+		path := oldDef.Name + "." + oldField.Name
+		newField := newDef.Fields.ForName(oldField.Name)
+		if newField == nil {
+			d.add(Breaking, "FIELD_REMOVED", path, "%s was removed.", path)
+			continue
+		}
+
+		if oldField.Type.String() != newField.Type.String() {
+			if safeInputChange(oldField.Type, newField.Type) {
+				d.add(Safe, "FIELD_TYPE_CHANGED", path,
+					"%s changed type from %s to %s.", path, oldField.Type, newField.Type)
+			} else {
+				d.add(Breaking, "FIELD_CHANGED_KIND", path,
+					"%s changed type from %s to %s.", path, oldField.Type, newField.Type)
+			}
+		}
+		if valueString(oldField.DefaultValue) != valueString(newField.DefaultValue) {
+			d.add(Dangerous, "FIELD_DEFAULT_VALUE_CHANGE", path,
+				"Default value of %s changed from %s to %s.",
+				path, valueString(oldField.DefaultValue), valueString(newField.DefaultValue))
+		}
+		if oldField.Description != newField.Description {
+			d.add(Safe, "DESCRIPTION_CHANGED", path, "Description of %s changed.", path)
+		}
+		d.deprecation(path, oldField.Directives, newField.Directives)
+	}
+	for _, newField := range newDef.Fields {
+		if oldDef.Fields.ForName(newField.Name) != nil {
+			continue
+		}
+		path := oldDef.Name + "." + newField.Name
+		if isRequired(newField.Type, newField.DefaultValue) {
+			d.add(Breaking, "REQUIRED_INPUT_FIELD_ADDED", path, "Required input field %s was added.", path)
+		} else {
+			d.add(Dangerous, "OPTIONAL_INPUT_FIELD_ADDED", path, "Optional input field %s was added.", path)
+		}
+	}
+}
+
+// arguments compares field or directive arguments, prefix tells the two apart in change types.
+func (d *differ) arguments(parent string, oldArgs, newArgs ast.ArgumentDefinitionList, prefix string) {
+	for _, oldArg := range oldArgs {
+		path := parent + "(" + oldArg.Name + ":)"
+		newArg := newArgs.ForName(oldArg.Name)
+		if newArg == nil {
+			d.add(Breaking, prefix+"_REMOVED", path, "Argument %s was removed from %s.", oldArg.Name, parent)
+			continue
+		}
+
+		if oldArg.Type.String() != newArg.Type.String() {
+			if safeInputChange(oldArg.Type, newArg.Type) {
+				d.add(Safe, prefix+"_TYPE_CHANGED", path,
+					"Argument %s on %s changed type from %s to %s.", oldArg.Name, parent, oldArg.Type, newArg.Type)
+			} else {
+				d.add(Breaking, prefix+"_CHANGED_KIND", path,
+					"Argument %s on %s changed type from %s to %s.", oldArg.Name, parent, oldArg.Type, newArg.Type)
+			}
+		}
+		if valueString(oldArg.DefaultValue) != valueString(newArg.DefaultValue) {
+			d.add(Dangerous, prefix+"_DEFAULT_VALUE_CHANGE", path,
+				"Default value of argument %s on %s changed from %s to %s.",
+				oldArg.Name, parent, valueString(oldArg.DefaultValue), valueString(newArg.DefaultValue))
+		}
+		if oldArg.Description != newArg.Description {
+			d.add(Safe, "DESCRIPTION_CHANGED", path, "Description of argument %s on %s changed.", oldArg.Name, parent)
+		}
+	}
+	for _, newArg := range newArgs {
+		if oldArgs.ForName(newArg.Name) != nil {
+			continue
+		}
+		path := parent + "(" + newArg.Name + ":)"
+		if isRequired(newArg.Type, newArg.DefaultValue) {
+			d.add(Breaking, "REQUIRED_"+prefix+"_ADDED", path, "Required argument %s was added to %s.", newArg.Name, parent)
+		} else if prefix == "DIRECTIVE_ARG" {
+			d.add(Safe, "OPTIONAL_"+prefix+"_ADDED", path, "Optional argument %s was added to %s.", newArg.Name, parent)
+		} else {
+			d.add(Dangerous, "OPTIONAL_"+prefix+"_ADDED", path, "Optional argument %s was added to %s.", newArg.Name, parent)
+		}
+	}
+}
+
+func (d *differ) unionMembers(oldDef, newDef *ast.Definition) {
+	for _, member := range oldDef.Types {
+		if !slices.Contains(newDef.Types, member) {
+			d.add(Breaking, "TYPE_REMOVED_FROM_UNION", oldDef.Name,
+				"%s was removed from union %s.", member, oldDef.Name)
+		}
+	}
+	for _, member := range newDef.Types {
+		if !slices.Contains(oldDef.Types, member) {
+			d.add(Dangerous, "TYPE_ADDED_TO_UNION", oldDef.Name,
+				"%s was added to union %s.", member, oldDef.Name)
+		}
+	}
+}
+
+func (d *differ) enumValues(oldDef, newDef *ast.Definition) {
+	for _, oldValue := range oldDef.EnumValues {
+		path := oldDef.Name + "." + oldValue.Name
+		newValue := newDef.EnumValues.ForName(oldValue.Name)
+		if newValue == nil {
+			d.add(Breaking, "VALUE_REMOVED_FROM_ENUM", path,
+				"%s was removed from enum %s.", oldValue.Name, oldDef.Name)
+			continue
+		}
+		if oldValue.Description != newValue.Description {
+			d.add(Safe, "DESCRIPTION_CHANGED", path, "Description of %s changed.", path)
+		}
+		d.deprecation(path, oldValue.Directives, newValue.Directives)
+	}
+	for _, newValue := range newDef.EnumValues {
+		if oldDef.EnumValues.ForName(newValue.Name) == nil {
+			d.add(Dangerous, "VALUE_ADDED_TO_ENUM", oldDef.Name+"."+newValue.Name,
+				"%s was added to enum %s.", newValue.Name, oldDef.Name)
+		}
+	}
+}
+
+func (d *differ) deprecation(path string, oldDirectives, newDirectives ast.DirectiveList) {
+	wasDeprecated := oldDirectives.ForName("deprecated") != nil
+	isDeprecated := newDirectives.ForName("deprecated") != nil
+	switch {
+	case !wasDeprecated && isDeprecated:
+		d.add(Safe, "DEPRECATION_ADDED", path, "%s was deprecated.", path)
+	case wasDeprecated && !isDeprecated:
+		d.add(Safe, "DEPRECATION_REMOVED", path, "%s is no longer deprecated.", path)
+	}
+}
+
+func (d *differ) directive(oldDir, newDir *ast.DirectiveDefinition) {
+	path := "@" + oldDir.Name
+	if oldDir.Description != newDir.Description {
+		d.add(Safe, "DESCRIPTION_CHANGED", path, "Description of %s changed.", path)
+	}
+	if oldDir.IsRepeatable && !newDir.IsRepeatable {
+		d.add(Breaking, "DIRECTIVE_REPEATABLE_REMOVED", path, "%s is no longer repeatable.", path)
+	}
+	for _, loc := range oldDir.Locations {
+		if !slices.Contains(newDir.Locations, loc) {
+			d.add(Breaking, "DIRECTIVE_LOCATION_REMOVED", path, "%s can no longer be used on %s.", path, loc)
+		}
+	}
+	for _, loc := range newDir.Locations {
+		if !slices.Contains(oldDir.Locations, loc) {
+			d.add(Safe, "DIRECTIVE_LOCATION_ADDED", path, "%s can now be used on %s.", path, loc)
+		}
+	}
+	d.arguments(path, oldDir.Arguments, newDir.Arguments, "DIRECTIVE_ARG")
+}
+
+// safeOutputChange reports whether values of newType can be read by clients expecting oldType:
+// the type may only become stricter about nulls.
+func safeOutputChange(oldType, newType *ast.Type) bool {
+	if oldType.NonNull && !newType.NonNull {
+		return false
+	}
+	if oldType.Elem != nil || newType.Elem != nil {
+		return oldType.Elem != nil && newType.Elem != nil && safeOutputChange(oldType.Elem, newType.Elem)
+	}
+	return oldType.NamedType == newType.NamedType
+}
+
+// safeInputChange reports whether every value clients could send as oldType is accepted as
+// newType: the type may only become more lenient about nulls.
+func safeInputChange(oldType, newType *ast.Type) bool {
+	if newType.NonNull && !oldType.NonNull {
+		return false
+	}
+	if oldType.Elem != nil || newType.Elem != nil {
+		return oldType.Elem != nil && newType.Elem != nil && safeInputChange(oldType.Elem, newType.Elem)
+	}
+	return oldType.NamedType == newType.NamedType
+}
+
+func isRequired(t *ast.Type, defaultValue *ast.Value) bool {
+	return t.NonNull && defaultValue == nil
+}
+
+func valueString(v *ast.Value) string {
+	if v == nil {
+		return "none"
+	}
+	return v.String()
+}
+
+func isBuiltInDirective(d *ast.DirectiveDefinition) bool {
+	return d.Position != nil && d.Position.Src != nil && d.Position.Src.BuiltIn
+}
+
+func kindName(k ast.DefinitionKind) string {
+	switch k {
+	case ast.Scalar:
+		return "a scalar"
+	case ast.Object:
+		return "an object type"
+	case ast.Interface:
+		return "an interface"
+	case ast.Union:
+		return "a union"
+	case ast.Enum:
+		return "an enum"
+	case ast.InputObject:
+		return "an input type"
+	default:
+		return string(k)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
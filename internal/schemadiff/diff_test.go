@@ -0,0 +1,227 @@
+package schemadiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func load(t *testing.T, sdl string) *ast.Schema {
+	t.Helper()
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphqls", Input: sdl})
+	require.NoError(t, err)
+	return schema
+}
+
+func find(changes []Change, typ, path string) *Change {
+	for i := range changes {
+		if changes[i].Type == typ && changes[i].Path == path {
+			return &changes[i]
+		}
+	}
+	return nil
+}
+
+func TestDiff(t *testing.T) {
+	oldSchema := load(t, `
+		directive @cache(maxAge: Int) repeatable on FIELD_DEFINITION | OBJECT
+		type Query {
+			user(id: ID!, limit: Int = 10): User
+			users(filter: Filter): [User!]!
+			legacy: String
+			name: String
+		}
+		type User implements Node { id: ID! role: Role email: String }
+		interface Node { id: ID! }
+		type Admin { id: ID! }
+		union Actor = User | Admin
+		enum Role { ADMIN USER GUEST }
+		input Filter { name: String age: Int = 18 }
+		scalar Removed
+	`)
+	newSchema := load(t, `
+		directive @cache(maxAge: Int, scope: String!) on FIELD_DEFINITION
+		type Query {
+			user(id: ID, limit: Int = 20, locale: String): User!
+			users(filter: Filter!): [User!]!
+			name: Int
+			created: String
+		}
+		type User { id: ID! role: Role email: String @deprecated }
+		interface Node { id: ID! }
+		type Admin { id: ID! }
+		type Bot { id: ID! }
+		union Actor = Admin | Bot
+		enum Role { ADMIN USER OWNER }
+		input Filter { name: String age: Int = 21 required: Boolean! }
+	`)
+
+	changes := Diff(oldSchema, newSchema)
+
+	for _, tc := range []struct {
+		level Level
+		typ   string
+		path  string
+	}{
+		{Breaking, "TYPE_REMOVED", "Removed"},
+		{Breaking, "FIELD_REMOVED", "Query.legacy"},
+		{Breaking, "FIELD_CHANGED_KIND", "Query.name"},
+		{Breaking, "ARG_CHANGED_KIND", "Query.users(filter:)"},
+		{Breaking, "IMPLEMENTED_INTERFACE_REMOVED", "User"},
+		{Breaking, "TYPE_REMOVED_FROM_UNION", "Actor"},
+		{Breaking, "VALUE_REMOVED_FROM_ENUM", "Role.GUEST"},
+		{Breaking, "REQUIRED_INPUT_FIELD_ADDED", "Filter.required"},
+		{Breaking, "REQUIRED_DIRECTIVE_ARG_ADDED", "@cache(scope:)"},
+		{Breaking, "DIRECTIVE_REPEATABLE_REMOVED", "@cache"},
+		{Breaking, "DIRECTIVE_LOCATION_REMOVED", "@cache"},
+		{Dangerous, "ARG_DEFAULT_VALUE_CHANGE", "Query.user(limit:)"},
+		{Dangerous, "FIELD_DEFAULT_VALUE_CHANGE", "Filter.age"},
+		{Dangerous, "OPTIONAL_ARG_ADDED", "Query.user(locale:)"},
+		{Dangerous, "TYPE_ADDED_TO_UNION", "Actor"},
+		{Dangerous, "VALUE_ADDED_TO_ENUM", "Role.OWNER"},
+		{Safe, "FIELD_TYPE_CHANGED", "Query.user"},
+		{Safe, "ARG_TYPE_CHANGED", "Query.user(id:)"},
+		{Safe, "FIELD_ADDED", "Query.created"},
+		{Safe, "TYPE_ADDED", "Bot"},
+		{Safe, "DEPRECATION_ADDED", "User.email"},
+	} {
+		t.Run(tc.typ+" "+tc.path, func(t *testing.T) {
+			c := find(changes, tc.typ, tc.path)
+			require.NotNil(t, c, "missing change, got %v", changes)
+			require.Equal(t, tc.level, c.Level)
+		})
+	}
+
+	t.Run("breaking changes come first", func(t *testing.T) {
+		for i := 1; i < len(changes); i++ {
+			require.LessOrEqual(t, changes[i-1].Level.order(), changes[i].Level.order())
+		}
+	})
+
+	t.Run("built in types are ignored", func(t *testing.T) {
+		require.Empty(t, Diff(load(t, `type Query { a: String }`), load(t, `type Query { a: String }`)))
+	})
+
+	t.Run("changed kind", func(t *testing.T) {
+		changes := Diff(
+			load(t, `type Query { a: A } type A { id: ID }`),
+			load(t, `type Query { a: A } interface A { id: ID }`),
+		)
+		require.Equal(t, []Change{{
+			Level:   Breaking,
+			Type:    "TYPE_CHANGED_KIND",
+			Path:    "A",
+			Message: "A changed from an object type to an interface.",
+		}}, changes)
+	})
+}
+
+func TestReport(t *testing.T) {
+	changes := []Change{
+		{Level: Breaking, Type: "FIELD_REMOVED", Path: "Query.a", Message: "Query.a was removed."},
+		{Level: Safe, Type: "FIELD_ADDED", Path: "Query.b", Message: "Query.b was added."},
+	}
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteText(&buf, changes))
+		require.Equal(t, `BREAKING (1):
+  FIELD_REMOVED  Query.a was removed.
+SAFE (1):
+  FIELD_ADDED  Query.b was added.
+
+1 breaking, 0 dangerous, 1 safe changes
+`, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteJSON(&buf, changes))
+
+		var got report
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		require.Equal(t, report{Breaking: 1, Safe: 1, Changes: changes}, got)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteText(&buf, nil))
+		require.Equal(t, "No changes.\n", buf.String())
+
+		buf.Reset()
+		require.NoError(t, WriteJSON(&buf, nil))
+		require.JSONEq(t, `{"breaking":0,"dangerous":0,"safe":0,"changes":[]}`, buf.String())
+	})
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	sdl := filepath.Join(dir, "schema.graphqls")
+	require.NoError(t, os.WriteFile(sdl, []byte(`type Query { a: String }`), 0o644))
+	schema, err := LoadFile(sdl)
+	require.NoError(t, err)
+	require.NotNil(t, schema.Query.Fields.ForName("a"))
+
+	introspection := filepath.Join(dir, "schema.json")
+	require.NoError(t, os.WriteFile(introspection, []byte(`{"data":{"__schema":{
+		"queryType":{"name":"Query"},
+		"types":[{"kind":"OBJECT","name":"Query","fields":[
+			{"name":"b","args":[],"type":{"kind":"SCALAR","name":"String"}}
+		],"interfaces":[]}],
+		"directives":[]
+	}}}`), 0o644))
+	schema, err = LoadFile(introspection)
+	require.NoError(t, err)
+	require.NotNil(t, schema.Query.Fields.ForName("b"))
+}
+
+func TestLoadGitRevision(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	write := func(name, content string) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o644))
+	}
+
+	run("init", "-q")
+	write("graph/schema.graphqls", `type Query { a: String }`)
+	write("graph/user.graphqls", `type User { id: ID! } extend type Query { user: User }`)
+	run("add", ".")
+	run("commit", "-q", "-m", "initial")
+
+	require.NoError(t, os.Remove("graph/user.graphqls"))
+	write("graph/schema.graphqls", `type Query { a: String b: String }`)
+	write("graph/post.graphqls", `type Post { id: ID! }`)
+
+	schema, err := LoadGitRevision("HEAD", []string{"graph/schema.graphqls", "graph/post.graphqls"})
+	require.NoError(t, err)
+	require.Nil(t, schema.Query.Fields.ForName("b"))
+	require.NotNil(t, schema.Types["User"], "deleted schema files are part of the baseline")
+	require.Nil(t, schema.Types["Post"])
+
+	_, err = LoadGitRevision("does-not-exist", []string{"graph/schema.graphqls"})
+	require.Error(t, err)
+}
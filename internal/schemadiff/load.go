@@ -0,0 +1,145 @@
+package schemadiff
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/internal/introspection"
+)
+
+// LoadFile loads a baseline schema from a file holding either SDL or the JSON result of an
+// introspection query.
+func LoadFile(filename string) (*ast.Schema, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read baseline: %w", err)
+	}
+
+	source := &ast.Source{Name: filename, Input: string(b)}
+	if filepath.Ext(filename) == ".json" || introspection.IsIntrospection(b) {
+		source, err = introspection.Source(filename, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sources, err := config.AppendConnectionSource([]*ast.Source{source})
+	if err != nil {
+		return nil, err
+	}
+	schema, err := gqlparser.LoadSchema(sources...)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// LoadGitRevision loads a baseline schema from the given schema files as they were at rev in
+// the git repository holding the working directory. Files that did not exist at rev are
+// skipped. Schema files deleted since rev are included when they sit under the directory of a
+// current schema file and share its extension, so types removed with their file still show up
+// in the diff.
+func LoadGitRevision(rev string, filenames []string) (*ast.Schema, error) {
+	paths := map[string]bool{}
+	var sources []*ast.Source
+
+	addFile := func(filename string) error {
+		rel, err := relative(filename)
+		if err != nil {
+			return err
+		}
+		if paths[rel] {
+			return nil
+		}
+		paths[rel] = true
+
+		object := rev + ":./" + filepath.ToSlash(rel)
+		if _, err := git("cat-file", "-e", object); err != nil {
+			return nil
+		}
+		b, err := git("show", object)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, &ast.Source{Name: object, Input: string(b)})
+		return nil
+	}
+
+	type pattern struct{ dir, ext string }
+	var patterns []pattern
+	for _, filename := range filenames {
+		if err := addFile(filename); err != nil {
+			return nil, err
+		}
+		rel, _ := relative(filename)
+		patterns = append(patterns, pattern{dir: filepath.Dir(rel), ext: filepath.Ext(rel)})
+	}
+
+	deleted, err := git("diff", "--name-only", "--relative", "--diff-filter=D", rev)
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range strings.Split(strings.TrimSpace(string(deleted)), "\n") {
+		if filename == "" {
+			continue
+		}
+		for _, p := range patterns {
+			if filepath.Ext(filename) == p.ext && isUnder(filepath.FromSlash(filename), p.dir) {
+				if err := addFile(filename); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no schema files found at %s", rev)
+	}
+	sources, err = config.AppendConnectionSource(sources)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := gqlparser.LoadSchema(sources...)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func git(args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func relative(filename string) (string, error) {
+	if !filepath.IsAbs(filename) {
+		return filepath.Clean(filename), nil
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Rel(wd, filename)
+}
+
+func isUnder(filename, dir string) bool {
+	if dir == "." {
+		return !strings.HasPrefix(filename, "..")
+	}
+	return strings.HasPrefix(filename, dir+string(filepath.Separator))
+}
@@ -0,0 +1,70 @@
+package schemadiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Count returns the number of changes at level.
+func Count(changes []Change, level Level) int {
+	n := 0
+	for _, c := range changes {
+		if c.Level == level {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText writes the changes grouped by level, one per line.
+func WriteText(w io.Writer, changes []Change) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "No changes.")
+		return err
+	}
+
+	for _, level := range []Level{Breaking, Dangerous, Safe} {
+		n := Count(changes, level)
+		if n == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s (%d):\n", level, n); err != nil {
+			return err
+		}
+		for _, c := range changes {
+			if c.Level != level {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "  %s  %s\n", c.Type, c.Message); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d breaking, %d dangerous, %d safe changes\n",
+		Count(changes, Breaking), Count(changes, Dangerous), Count(changes, Safe))
+	return err
+}
+
+type report struct {
+	Breaking  int      `json:"breaking"`
+	Dangerous int      `json:"dangerous"`
+	Safe      int      `json:"safe"`
+	Changes   []Change `json:"changes"`
+}
+
+// WriteJSON writes the changes with a count per level as a JSON object.
+func WriteJSON(w io.Writer, changes []Change) error {
+	if changes == nil {
+		changes = []Change{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report{
+		Breaking:  Count(changes, Breaking),
+		Dangerous: Count(changes, Dangerous),
+		Safe:      Count(changes, Safe),
+		Changes:   changes,
+	})
+}
@@ -13,14 +13,24 @@ import (
 	"io/fs"
 	"log"
 	"os"
+	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"syscall"
 
 	"github.com/urfave/cli/v3"
+	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/client/clientgen"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/internal/code"
+	"github.com/99designs/gqlgen/internal/fromgo"
+	"github.com/99designs/gqlgen/internal/lint"
+	"github.com/99designs/gqlgen/internal/schemadiff"
+	"github.com/99designs/gqlgen/plugin/mockgen"
 	"github.com/99designs/gqlgen/plugin/servergen"
 )
 
@@ -165,6 +175,64 @@ var initCmd = &cli.Command{
 var generateCmd = &cli.Command{
 	Name:  "generate",
 	Usage: "generate a graphql server based on schema",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.BoolFlag{
+			Name:  "watch",
+			Usage: "regenerate whenever the config, the schema or the bound go packages change",
+		},
+		&cli.BoolFlag{
+			Name:  "refresh-schema",
+			Usage: "introspect the schema endpoints again instead of reading them from the lockfile",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		configFilename := c.String("config")
+		refresh := c.Bool("refresh-schema")
+		load := func() (*config.Config, error) {
+			var cfg *config.Config
+			var err error
+			if configFilename != "" {
+				cfg, err = config.LoadConfig(configFilename)
+			} else {
+				cfg, err = config.LoadConfigFromDefaultLocations()
+				if errors.Is(err, fs.ErrNotExist) {
+					cfg, err = config.LoadDefaultConfig()
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			// only the first load refreshes, watching reads the lockfile written by it
+			cfg.RefreshSchemaEndpoints = refresh
+			refresh = false
+			return cfg, nil
+		}
+
+		if c.Bool("watch") {
+			if configFilename == "" {
+				// a missing config falls back to the defaults, there is nothing to watch then
+				configFilename, _ = config.FindConfig()
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			w := &api.Watcher{Load: load, ConfigFilename: configFilename}
+			return w.Run(ctx)
+		}
+
+		cfg, err := load()
+		if err != nil {
+			return err
+		}
+		return api.Generate(cfg)
+	},
+}
+
+var clientCmd = &cli.Command{
+	Name:  "client",
+	Usage: "generate a typed go client from the operations listed in the client section of the config",
 	Flags: []cli.Flag{
 		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
 		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
@@ -174,21 +242,271 @@ var generateCmd = &cli.Command{
 		var err error
 		if configFilename := c.String("config"); configFilename != "" {
 			cfg, err = config.LoadConfig(configFilename)
-			if err != nil {
-				return err
-			}
 		} else {
 			cfg, err = config.LoadConfigFromDefaultLocations()
-			if errors.Is(err, fs.ErrNotExist) {
-				cfg, err = config.LoadDefaultConfig()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := cfg.Init(); err != nil {
+			return err
+		}
+		return clientgen.Generate(cfg)
+	},
+}
+
+var mockCmd = &cli.Command{
+	Name:  "mock",
+	Usage: "serve fake data for the schema",
+	Commands: []*cli.Command{
+		mockServeCmd,
+	},
+}
+
+var mockServeCmd = &cli.Command{
+	Name:  "serve",
+	Usage: "generate resolvers returning fake data and serve them",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.StringFlag{
+			Name:  "filename",
+			Usage: "where to generate the resolvers, mock/mock.go next to the exec when not set",
+		},
+		&cli.StringFlag{Name: "port", Usage: "the port to listen on", Value: "8080"},
+		&cli.Uint64Flag{Name: "seed", Usage: "the seed of the fake data"},
+		&cli.IntFlag{Name: "list-length", Usage: "the number of items in lists", Value: 3},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		var cfg *config.Config
+		var err error
+		if configFilename := c.String("config"); configFilename != "" {
+			cfg, err = config.LoadConfig(configFilename)
+		} else {
+			cfg, err = config.LoadConfigFromDefaultLocations()
+		}
+		if err != nil {
+			return err
+		}
+
+		filename := c.String("filename")
+		if filename == "" {
+			filename = filepath.Join(cfg.Exec.Dir(), "mock", "mock.go")
+		}
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			return err
+		}
+		// the server is a main package next to the mock so it can import it, it is removed on exit
+		serverDir, err := os.MkdirTemp(filepath.Dir(filename), "server")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(serverDir)
+
+		plugin := mockgen.New(filename, "Resolver", mockgen.WithServer(filepath.Join(serverDir, "main.go")))
+		if err := api.Generate(cfg, api.AddPlugin(plugin)); err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		dir, err := filepath.Abs(serverDir)
+		if err != nil {
+			return err
+		}
+		cmd := exec.CommandContext(ctx, "go", "run", dir,
+			"-port", c.String("port"),
+			"-seed", fmt.Sprint(c.Uint64("seed")),
+			"-list-length", fmt.Sprint(c.Int("list-length")),
+		)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil && ctx.Err() == nil {
+			return err
+		}
+		return nil
+	},
+}
+
+var schemaCmd = &cli.Command{
+	Name:  "schema",
+	Usage: "inspect the schema",
+	Commands: []*cli.Command{
+		schemaDiffCmd,
+		schemaFromGoCmd,
+	},
+}
+
+var schemaFromGoCmd = &cli.Command{
+	Name:      "from-go",
+	Usage:     "write a schema for the types of a go package and bind them in the config",
+	ArgsUsage: "<package>",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "the schema file to write, the schema is printed when not set",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		pkg := c.Args().First()
+		if pkg == "" {
+			return errors.New("a package is required, either an import path or a directory")
+		}
+		if strings.HasPrefix(pkg, ".") || filepath.IsAbs(pkg) {
+			pkg = code.ImportPathForDir(pkg)
+		}
+
+		res, err := fromgo.Extract(pkg)
+		if err != nil {
+			return err
+		}
+		for _, warning := range res.Warnings {
+			fmt.Fprintln(os.Stderr, warning)
+		}
+
+		var schema bytes.Buffer
+		res.WriteSchema(&schema)
+		if output := c.String("output"); output != "" {
+			if err := initFile(output, schema.String()); err != nil {
+				return err
 			}
+		} else {
+			fmt.Print(schema.String())
+		}
 
-			if err != nil {
+		configFilename := c.String("config")
+		if configFilename == "" {
+			if configFilename, err = config.FindConfig(); err != nil {
 				return err
 			}
 		}
+		kept, err := fromgo.WriteModels(configFilename, res.Models)
+		if err != nil {
+			return err
+		}
+		for _, name := range kept {
+			fmt.Fprintf(os.Stderr, "%s: already bound in %s, keeping the existing binding\n", name, configFilename)
+		}
+		return nil
+	},
+}
 
-		return api.Generate(cfg)
+var schemaDiffCmd = &cli.Command{
+	Name:  "diff",
+	Usage: "compare the schema against a baseline and report breaking changes",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.StringFlag{
+			Name:  "baseline",
+			Usage: "the baseline schema, either an SDL file or an introspection result in JSON",
+		},
+		&cli.StringFlag{
+			Name:  "git-ref",
+			Usage: "read the baseline from the schema files at this git revision",
+		},
+		&cli.StringFlag{Name: "format", Usage: "the output format, text or json", Value: "text"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		var cfg *config.Config
+		var err error
+		if configFilename := c.String("config"); configFilename != "" {
+			cfg, err = config.LoadConfig(configFilename)
+		} else {
+			cfg, err = config.LoadConfigFromDefaultLocations()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := cfg.LoadSchema(); err != nil {
+			return err
+		}
+		schema := cfg.Schema
+
+		var baseline *ast.Schema
+		switch {
+		case c.String("baseline") != "" && c.String("git-ref") != "":
+			return errors.New("only one of --baseline and --git-ref can be set")
+		case c.String("baseline") != "":
+			baseline, err = schemadiff.LoadFile(c.String("baseline"))
+		case c.String("git-ref") != "":
+			baseline, err = schemadiff.LoadGitRevision(c.String("git-ref"), cfg.SchemaFilename)
+		default:
+			return errors.New("a baseline is required, set --baseline or --git-ref")
+		}
+		if err != nil {
+			return err
+		}
+
+		changes := schemadiff.Diff(baseline, schema)
+		switch c.String("format") {
+		case "text":
+			err = schemadiff.WriteText(os.Stdout, changes)
+		case "json":
+			err = schemadiff.WriteJSON(os.Stdout, changes)
+		default:
+			return fmt.Errorf("unknown format %q, expected text or json", c.String("format"))
+		}
+		if err != nil {
+			return err
+		}
+
+		if n := schemadiff.Count(changes, schemadiff.Breaking); n > 0 {
+			return fmt.Errorf("found %d breaking changes", n)
+		}
+		return nil
+	},
+}
+
+var lintCmd = &cli.Command{
+	Name:  "lint",
+	Usage: "check the schema against the rules configured in the lint section of the config",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.StringFlag{Name: "format", Usage: "the output format, text or sarif", Value: "text"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		var cfg *config.Config
+		var err error
+		if configFilename := c.String("config"); configFilename != "" {
+			cfg, err = config.LoadConfig(configFilename)
+		} else {
+			cfg, err = config.LoadConfigFromDefaultLocations()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := cfg.LoadSchema(); err != nil {
+			return err
+		}
+		diagnostics, err := lint.Run(cfg.Schema, cfg.Lint)
+		if err != nil {
+			return err
+		}
+
+		switch c.String("format") {
+		case "text":
+			err = lint.WriteText(os.Stdout, diagnostics)
+		case "sarif":
+			err = lint.WriteSARIF(os.Stdout, diagnostics)
+		default:
+			return fmt.Errorf("unknown format %q, expected text or sarif", c.String("format"))
+		}
+		if err != nil {
+			return err
+		}
+
+		if n := lint.Count(diagnostics, lint.Error); n > 0 {
+			return fmt.Errorf("found %d lint errors", n)
+		}
+		return nil
 	},
 }
 
@@ -222,6 +540,10 @@ func main() {
 	app.Commands = []*cli.Command{
 		generateCmd,
 		initCmd,
+		clientCmd,
+		schemaCmd,
+		lintCmd,
+		mockCmd,
 		versionCmd,
 	}
 
@@ -0,0 +1,195 @@
+// Package mockgen generates resolvers answering every field with deterministic fake data, so
+// clients can be built against a schema before its resolvers are.
+package mockgen
+
+import (
+	_ "embed"
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/template"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/internal/code"
+	"github.com/99designs/gqlgen/plugin"
+	"github.com/99designs/gqlgen/plugin/mockgen/mockruntime"
+)
+
+//go:embed mock.gotpl
+var mockTemplate string
+
+//go:embed server.gotpl
+var serverTemplate string
+
+// Directives declares the directives read by the generated resolvers, add it to the schema to
+// use them.
+const Directives = `directive @mock(value: String, length: Int) on FIELD_DEFINITION
+directive @fake(kind: String!) on FIELD_DEFINITION
+`
+
+// New returns a plugin generating, in filename, a resolver of type typename that answers every
+// field with fake data. It must be in another package than the exec.
+func New(filename, typename string, opts ...Option) plugin.Plugin {
+	p := &Plugin{filename: filename, typeName: typename}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+type Option func(*Plugin)
+
+// WithServer also generates, in filename, a main package serving the fake data.
+func WithServer(filename string) Option {
+	return func(p *Plugin) {
+		p.serverFilename = filename
+	}
+}
+
+type Plugin struct {
+	filename       string
+	typeName       string
+	serverFilename string
+}
+
+var (
+	_ plugin.CodeGenerator = &Plugin{}
+	_ plugin.ConfigMutator = &Plugin{}
+)
+
+func (m *Plugin) Name() string {
+	return "mockgen"
+}
+
+func (m *Plugin) MutateConfig(cfg *config.Config) error {
+	_ = syscall.Unlink(m.filename)
+	if m.serverFilename != "" {
+		_ = syscall.Unlink(m.serverFilename)
+	}
+	return nil
+}
+
+func (m *Plugin) GenerateCode(data *codegen.Data) error {
+	abs, err := filepath.Abs(m.filename)
+	if err != nil {
+		return err
+	}
+	importPath := code.ImportPathForDir(filepath.Dir(abs))
+	if importPath == data.Config.Exec.GetImportPath() {
+		return fmt.Errorf("%s must be in another package than the exec", m.filename)
+	}
+	if err := checkDirectives(data.Schema); err != nil {
+		return err
+	}
+
+	build := &MockBuild{
+		Data:           data,
+		TypeName:       m.typeName,
+		ExecImportPath: data.Config.Exec.GetImportPath(),
+		ImportPath:     importPath,
+	}
+	for _, o := range data.Objects {
+		if o.Root || o.IsReserved() {
+			continue
+		}
+		bound := MockObject{Name: o.Name, Type: o.Type, Fields: map[string]string{}}
+		for _, f := range o.Fields {
+			if f.IsVariable() && !f.IsResolver {
+				bound.Fields[f.Name] = f.GoFieldName
+			}
+		}
+		build.BoundObjects = append(build.BoundObjects, bound)
+	}
+	sort.Slice(build.BoundObjects, func(i, j int) bool {
+		return build.BoundObjects[i].Name < build.BoundObjects[j].Name
+	})
+
+	funcs := template.FuncMap{
+		"directiveSignature": func(d *codegen.Directive) string {
+			return strings.TrimPrefix(d.Declaration(), d.CallName()+" func")
+		},
+	}
+
+	err = templates.Render(templates.Options{
+		PackageName:     code.NameForDir(filepath.Dir(abs)),
+		ImportPath:      importPath,
+		Filename:        m.filename,
+		Data:            build,
+		Funcs:           funcs,
+		GeneratedHeader: true,
+		Packages:        data.Config.Packages,
+		Template:        mockTemplate,
+	})
+	if err != nil || m.serverFilename == "" {
+		return err
+	}
+
+	return templates.Render(templates.Options{
+		PackageName:     "main",
+		Filename:        m.serverFilename,
+		Data:            build,
+		GeneratedHeader: true,
+		Packages:        data.Config.Packages,
+		Template:        serverTemplate,
+	})
+}
+
+// checkDirectives reports the @fake kinds and @mock lengths the runtime would fail on.
+func checkDirectives(schema *ast.Schema) error {
+	known := map[string]bool{}
+	for _, kind := range mockruntime.Kinds() {
+		known[kind] = true
+	}
+	names := make([]string, 0, len(schema.Types))
+	for name := range schema.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, f := range schema.Types[name].Fields {
+			if d := f.Directives.ForName("fake"); d != nil {
+				if kind := d.Arguments.ForName("kind"); kind != nil && !known[kind.Value.Raw] {
+					return fmt.Errorf("%s.%s: unknown fake kind %q, expected one of %s",
+						name, f.Name, kind.Value.Raw, strings.Join(mockruntime.Kinds(), ", "))
+				}
+			}
+			if d := f.Directives.ForName("mock"); d != nil {
+				if length := d.Arguments.ForName("length"); length != nil && length.Value.Kind != ast.NullValue {
+					if f.Type.Elem == nil {
+						return fmt.Errorf("%s.%s: @mock(length:) can only be used on lists", name, f.Name)
+					}
+					if n, err := strconv.Atoi(length.Value.Raw); err != nil || n < 0 {
+						return fmt.Errorf("%s.%s: @mock(length:) must not be negative", name, f.Name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type MockBuild struct {
+	*codegen.Data
+
+	TypeName       string
+	ExecImportPath string
+	ImportPath     string
+	BoundObjects   []MockObject
+}
+
+// MockObject is an object of the schema along with the Go type it is bound to.
+type MockObject struct {
+	Name string
+	Type types.Type
+	// Fields maps the schema fields bound to struct fields to their Go name.
+	Fields map[string]string
+}
@@ -0,0 +1,161 @@
+//go:generate go run ../../testdata/gqlgen.go -config testdata/gqlgen.yml -mock testdata/mock/mock.go
+package mockgen
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/plugin/mockgen/mockruntime"
+	"github.com/99designs/gqlgen/plugin/mockgen/testdata/generated"
+	"github.com/99designs/gqlgen/plugin/mockgen/testdata/mock"
+)
+
+func newClient(opts mockruntime.Options) *client.Client {
+	srv := handler.New(generated.NewExecutableSchema(mock.Config(opts)))
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.Websocket{})
+	return client.New(srv)
+}
+
+type user struct {
+	ID            string
+	Name          string
+	Email         string
+	Website       *string
+	Status        string
+	Role          string
+	Score         float64
+	Age           int
+	Admin         bool
+	Token         string
+	CreatedAt     string
+	SessionLength string
+	Friends       []struct{ Name string }
+	Posts         []struct {
+		Title  string
+		Author struct{ Email string }
+	}
+}
+
+const usersQuery = `{ users {
+	id name email website status role score age admin token createdAt sessionLength
+	friends { name }
+	posts { title author { email } }
+} }`
+
+func TestMock(t *testing.T) {
+	c := newClient(mockruntime.Options{Seed: 1})
+
+	t.Run("fills every selected field", func(t *testing.T) {
+		var resp struct{ Users []user }
+		c.MustPost(usersQuery, &resp)
+
+		require.Len(t, resp.Users, 2, "@mock(length:) sets the length")
+		for _, u := range resp.Users {
+			require.NotEmpty(t, u.ID)
+			require.Contains(t, u.Name, " ")
+			require.Contains(t, u.Email, "@example.")
+			require.NotNil(t, u.Website)
+			require.True(t, strings.HasPrefix(*u.Website, "https://"), *u.Website)
+			require.Equal(t, "active", u.Status)
+			require.Contains(t, []string{"ADMIN", "MEMBER"}, u.Role)
+			require.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), u.Token)
+			createdAt, err := time.Parse(time.RFC3339, u.CreatedAt)
+			require.NoError(t, err)
+			require.Equal(t, 2024, createdAt.Year())
+			require.Regexp(t, regexp.MustCompile(`^PT`), u.SessionLength)
+			require.Len(t, u.Friends, 3)
+			require.Len(t, u.Posts, 3)
+			require.Contains(t, u.Posts[0].Author.Email, "@")
+		}
+		require.NotEqual(t, resp.Users[0], resp.Users[1])
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		var first, second, other struct{ Users []user }
+		c.MustPost(usersQuery, &first)
+		c.MustPost(usersQuery, &second)
+		require.Equal(t, first, second)
+
+		newClient(mockruntime.Options{Seed: 2}).MustPost(usersQuery, &other)
+		require.NotEqual(t, first, other)
+	})
+
+	t.Run("picks implementations of interfaces and unions", func(t *testing.T) {
+		var resp struct {
+			Search []struct {
+				Typename string `json:"__typename"`
+				Title    string
+				Email    string
+			}
+			Node struct {
+				Typename string `json:"__typename"`
+				ID       string
+			}
+		}
+		c.MustPost(`{
+			search { __typename ... on Post { title } ... on User { email } }
+			node { __typename id }
+		}`, &resp)
+
+		require.Len(t, resp.Search, 3)
+		for _, result := range resp.Search {
+			switch result.Typename {
+			case "Post":
+				require.NotEmpty(t, result.Title)
+			case "User":
+				require.NotEmpty(t, result.Email)
+			default:
+				t.Fatalf("unexpected type %s", result.Typename)
+			}
+		}
+		require.Contains(t, []string{"Post", "User"}, resp.Node.Typename)
+		require.NotEmpty(t, resp.Node.ID)
+	})
+
+	t.Run("skips directives", func(t *testing.T) {
+		var resp struct{ Me struct{ Name string } }
+		c.MustPost(`{ me { name } }`, &resp)
+		require.NotEmpty(t, resp.Me.Name)
+	})
+
+	t.Run("streams subscriptions", func(t *testing.T) {
+		c := newClient(mockruntime.Options{Interval: time.Millisecond})
+		sub := c.Websocket(`subscription { userCreated { email } }`)
+		defer sub.Close()
+
+		var first, second struct{ UserCreated struct{ Email string } }
+		require.NoError(t, sub.Next(&first))
+		require.NoError(t, sub.Next(&second))
+		require.Contains(t, first.UserCreated.Email, "@")
+		require.NotEqual(t, first, second)
+	})
+}
+
+func TestCheckDirectives(t *testing.T) {
+	check := func(sdl string) error {
+		schema, err := gqlparser.LoadSchema(&ast.Source{Input: Directives + sdl})
+		require.NoError(t, err)
+		return checkDirectives(schema)
+	}
+
+	require.NoError(t, check(`type Query { emails: [String!]! @fake(kind: "email") @mock(length: 5) }`))
+	require.EqualError(t,
+		check(`type Query { email: String! @fake(kind: "mail") }`),
+		`Query.email: unknown fake kind "mail", expected one of `+strings.Join(mockruntime.Kinds(), ", "))
+	require.EqualError(t,
+		check(`type Query { email: String! @mock(length: 2) }`),
+		"Query.email: @mock(length:) can only be used on lists")
+	require.EqualError(t,
+		check(`type Query { emails: [String!]! @mock(length: -1) }`),
+		"Query.emails: @mock(length:) must not be negative")
+}
@@ -0,0 +1,130 @@
+package mockruntime
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+)
+
+// kinds are the values @fake(kind:) can ask for.
+var kinds = map[string]func(r *rand.Rand) string{
+	"word":      func(r *rand.Rand) string { return pick(r, lorem) },
+	"sentence":  func(r *rand.Rand) string { return sentence(r, 6+r.IntN(6)) },
+	"paragraph": paragraph,
+	"firstName": func(r *rand.Rand) string { return pick(r, firstNames) },
+	"lastName":  func(r *rand.Rand) string { return pick(r, lastNames) },
+	"name":      func(r *rand.Rand) string { return pick(r, firstNames) + " " + pick(r, lastNames) },
+	"username": func(r *rand.Rand) string {
+		return strings.ToLower(pick(r, firstNames)) + fmt.Sprint(r.IntN(100))
+	},
+	"email": func(r *rand.Rand) string {
+		return strings.ToLower(pick(r, firstNames)+"."+pick(r, lastNames)) + "@" + pick(r, domains)
+	},
+	"url": func(r *rand.Rand) string {
+		return "https://" + pick(r, domains) + "/" + pick(r, lorem)
+	},
+	"imageUrl": func(r *rand.Rand) string {
+		return fmt.Sprintf("https://picsum.photos/seed/%d/640/480", r.IntN(1000))
+	},
+	"phone": func(r *rand.Rand) string {
+		return fmt.Sprintf("+1 555 %03d %04d", r.IntN(1000), r.IntN(10000))
+	},
+	"city":    func(r *rand.Rand) string { return pick(r, cities) },
+	"country": func(r *rand.Rand) string { return pick(r, countries) },
+	"company": func(r *rand.Rand) string { return pick(r, lastNames) + " " + pick(r, companySuffixes) },
+	"color":   func(r *rand.Rand) string { return fmt.Sprintf("#%06x", r.IntN(0x1000000)) },
+	"ipv4": func(r *rand.Rand) string {
+		return fmt.Sprintf("%d.%d.%d.%d", 1+r.IntN(223), r.IntN(256), r.IntN(256), 1+r.IntN(254))
+	},
+	"uuid": func(r *rand.Rand) string {
+		var b [16]byte
+		for i := range b {
+			b[i] = byte(r.IntN(256))
+		}
+		b[6] = b[6]&0x0f | 0x40
+		b[8] = b[8]&0x3f | 0x80
+		return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+	},
+}
+
+// Kinds returns the kinds @fake(kind:) accepts.
+func Kinds() []string {
+	res := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		res = append(res, kind)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// kindForField guesses the kind of a string field from its name, falling back to a few words.
+func kindForField(name string) string {
+	name = strings.ToLower(name)
+	for _, guess := range []struct{ contains, kind string }{
+		{"email", "email"},
+		{"firstname", "firstName"},
+		{"lastname", "lastName"},
+		{"username", "username"},
+		{"avatar", "imageUrl"},
+		{"image", "imageUrl"},
+		{"url", "url"},
+		{"phone", "phone"},
+		{"city", "city"},
+		{"country", "country"},
+		{"company", "company"},
+		{"color", "color"},
+		{"name", "name"},
+		{"description", "paragraph"},
+		{"title", "sentence"},
+	} {
+		if strings.Contains(name, guess.contains) {
+			return guess.kind
+		}
+	}
+	return "sentence"
+}
+
+func pick(r *rand.Rand, list []string) string {
+	return list[r.IntN(len(list))]
+}
+
+func sentence(r *rand.Rand, words int) string {
+	parts := make([]string, words)
+	for i := range parts {
+		parts[i] = pick(r, lorem)
+	}
+	s := strings.Join(parts, " ")
+	return strings.ToUpper(s[:1]) + s[1:] + "."
+}
+
+func paragraph(r *rand.Rand) string {
+	parts := make([]string, 3+r.IntN(3))
+	for i := range parts {
+		parts[i] = sentence(r, 6+r.IntN(6))
+	}
+	return strings.Join(parts, " ")
+}
+
+var (
+	lorem = []string{
+		"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do",
+		"eiusmod", "tempor", "incididunt", "ut", "labore", "et", "dolore", "magna", "aliqua", "enim",
+		"ad", "minim", "veniam", "quis", "nostrud", "exercitation", "ullamco", "laboris", "nisi",
+		"aliquip", "ex", "ea", "commodo", "consequat", "duis", "aute", "irure", "in", "voluptate",
+	}
+	firstNames = []string{
+		"Ada", "Alan", "Barbara", "Claude", "Dennis", "Donald", "Edsger", "Frances", "Grace", "Guido",
+		"Hedy", "John", "Ken", "Linus", "Margaret", "Niklaus", "Radia", "Rob", "Sophie", "Tim",
+	}
+	lastNames = []string{
+		"Allen", "Berners-Lee", "Dijkstra", "Hamilton", "Hopper", "Kernighan", "Knuth", "Lamarr",
+		"Liskov", "Lovelace", "Perlman", "Pike", "Ritchie", "Shannon", "Thompson", "Torvalds",
+		"Turing", "van Rossum", "Wilson", "Wirth",
+	}
+	domains   = []string{"example.com", "example.net", "example.org"}
+	cities    = []string{"Amsterdam", "Berlin", "Buenos Aires", "Cairo", "Lagos", "Lisbon", "Melbourne", "Osaka", "Seoul", "Toronto"}
+	countries = []string{"Argentina", "Australia", "Canada", "Egypt", "Germany", "Japan", "Netherlands", "Nigeria", "Portugal", "South Korea"}
+
+	companySuffixes = []string{"Inc", "LLC", "Labs", "Group", "Systems"}
+)
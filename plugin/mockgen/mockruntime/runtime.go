@@ -0,0 +1,366 @@
+// Package mockruntime fills the results of the resolvers generated by mockgen with fake data.
+//
+// Values are derived from the seed and the path of each field in the response, so the same query
+// always gets the same data while different fields get different values. Only the fields
+// selected by the query are filled, which keeps recursive types finite.
+package mockruntime
+
+import (
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Options configures the data returned by a Faker.
+type Options struct {
+	// Seed changes every value returned, the same seed returns the same data.
+	Seed uint64
+	// ListLength is the number of items in lists without a @mock(length:), 3 when zero.
+	ListLength int
+	// Interval is the time between the events sent to subscriptions, a second when zero.
+	Interval time.Duration
+}
+
+// Faker produces the fake results of the fields of a schema.
+type Faker struct {
+	opts   Options
+	schema *ast.Schema
+	types  map[string]reflect.Type
+	fields map[string]map[string]string
+}
+
+// New returns a Faker for schema. types holds the Go types bound to the objects of the schema,
+// used to fill interfaces and unions, and fields the Go struct field bound to each schema field
+// keyed by object.
+func New(schema *ast.Schema, types map[string]reflect.Type, fields map[string]map[string]string, opts Options) *Faker {
+	if opts.ListLength == 0 {
+		opts.ListLength = 3
+	}
+	if opts.Interval == 0 {
+		opts.Interval = time.Second
+	}
+	return &Faker{opts: opts, schema: schema, types: types, fields: fields}
+}
+
+// Value returns the fake result of the field resolved in ctx.
+func Value[T any](ctx context.Context, f *Faker) (T, error) {
+	var res T
+	err := f.fillField(ctx, reflect.ValueOf(&res).Elem(), "")
+	return res, err
+}
+
+// Stream returns a channel sending a fake result of the subscription field resolved in ctx at
+// every interval, until ctx is done.
+func Stream[T any](ctx context.Context, f *Faker) (<-chan T, error) {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(f.opts.Interval)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			var res T
+			if err := f.fillField(ctx, reflect.ValueOf(&res).Elem(), strconv.Itoa(i)); err != nil {
+				graphql.AddError(ctx, err)
+				return
+			}
+			select {
+			case ch <- res:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (f *Faker) fillField(ctx context.Context, v reflect.Value, event string) error {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return errors.New("mockruntime: no field in context")
+	}
+	path := fc.Path().String()
+	if event != "" {
+		path += "#" + event
+	}
+	return f.fill(ctx, v, fc.Field.Definition.Type, fc.Field, path)
+}
+
+// fill sets v to a fake value of typ for field, selected at path in the response.
+func (f *Faker) fill(ctx context.Context, v reflect.Value, typ *ast.Type, field graphql.CollectedField, path string) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := f.fill(ctx, elem.Elem(), typ, field, path); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	if typ.Elem != nil {
+		n := f.opts.ListLength
+		if length, ok := directiveArg(field.Definition, "mock", "length"); ok {
+			n, _ = strconv.Atoi(length)
+		}
+		if v.Kind() == reflect.Interface {
+			list := make([]any, n)
+			for i := range list {
+				if err := f.fill(ctx, reflect.ValueOf(&list[i]).Elem(), typ.Elem, field, path+"."+strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+			v.Set(reflect.ValueOf(list))
+			return nil
+		}
+		if v.Kind() != reflect.Slice {
+			return fmt.Errorf("mockruntime: %s: can't fill %s with a list", path, v.Type())
+		}
+		list := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := f.fill(ctx, list.Index(i), typ.Elem, field, path+"."+strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(list)
+		return nil
+	}
+
+	def := f.schema.Types[typ.NamedType]
+	if def == nil {
+		return fmt.Errorf("mockruntime: %s: unknown type %s", path, typ.NamedType)
+	}
+	switch def.Kind {
+	case ast.Object:
+		return f.fillObject(ctx, v, def, field, path)
+	case ast.Interface, ast.Union:
+		return f.fillAbstract(ctx, v, def, field, path)
+	case ast.Enum:
+		value := def.EnumValues[random(f.opts.Seed, path).IntN(len(def.EnumValues))].Name
+		if mock, ok := directiveArg(field.Definition, "mock", "value"); ok {
+			value = mock
+		}
+		return set(v, value, path)
+	default:
+		value, err := f.scalar(def.Name, field.Definition, path)
+		if err != nil {
+			return err
+		}
+		return set(v, value, path)
+	}
+}
+
+func (f *Faker) fillObject(ctx context.Context, v reflect.Value, def *ast.Definition, field graphql.CollectedField, path string) error {
+	opCtx := graphql.GetOperationContext(ctx)
+	satisfies := append([]string{def.Name}, def.Interfaces...)
+	collected := graphql.CollectFields(opCtx, field.Selections, satisfies)
+
+	switch v.Kind() {
+	case reflect.Struct:
+		bound := f.fields[def.Name]
+		for _, child := range collected {
+			goName, ok := bound[child.Name]
+			if !ok {
+				// resolved by a method or a resolver
+				continue
+			}
+			fv := v.FieldByName(goName)
+			if !fv.IsValid() || !fv.CanSet() {
+				continue
+			}
+			if err := f.fill(ctx, fv, child.Definition.Type, child, path+"."+child.Alias); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map, reflect.Interface:
+		if v.Kind() == reflect.Map && v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		m := map[string]any{}
+		for _, child := range collected {
+			if strings.HasPrefix(child.Name, "__") {
+				continue
+			}
+			var value any
+			if err := f.fill(ctx, reflect.ValueOf(&value).Elem(), child.Definition.Type, child, path+"."+child.Alias); err != nil {
+				return err
+			}
+			m[child.Name] = value
+		}
+		return set(v, m, path)
+	}
+	return fmt.Errorf("mockruntime: %s: can't fill %s with %s", path, v.Type(), def.Name)
+}
+
+// fillAbstract fills v with one of the objects implementing def, picked by path.
+func (f *Faker) fillAbstract(ctx context.Context, v reflect.Value, def *ast.Definition, field graphql.CollectedField, path string) error {
+	type candidate struct {
+		name  string
+		value reflect.Value
+	}
+	var candidates []candidate
+	for _, possible := range f.schema.GetPossibleTypes(def) {
+		t, ok := f.types[possible.Name]
+		if !ok {
+			continue
+		}
+		for _, c := range []reflect.Type{t, reflect.PointerTo(t)} {
+			if c.AssignableTo(v.Type()) {
+				candidates = append(candidates, candidate{name: possible.Name, value: reflect.New(c).Elem()})
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return fmt.Errorf("mockruntime: %s: no bound type implements %s", path, def.Name)
+	}
+
+	c := candidates[random(f.opts.Seed, path).IntN(len(candidates))]
+	if err := f.fill(ctx, c.value, ast.NonNullNamedType(c.name, nil), field, path); err != nil {
+		return err
+	}
+	v.Set(c.value)
+	return nil
+}
+
+// scalar returns a fake value of the scalar named name, either a string, an int64, a float64,
+// a bool, a time.Time or a time.Duration.
+func (f *Faker) scalar(name string, field *ast.FieldDefinition, path string) (any, error) {
+	if value, ok := directiveArg(field, "mock", "value"); ok {
+		return value, nil
+	}
+	r := random(f.opts.Seed, path)
+	if kind, ok := directiveArg(field, "fake", "kind"); ok {
+		fake, ok := kinds[kind]
+		if !ok {
+			return nil, fmt.Errorf("mockruntime: %s: unknown fake kind %q", path, kind)
+		}
+		return fake(r), nil
+	}
+
+	switch name {
+	case "Int":
+		return int64(r.IntN(1000)), nil
+	case "Float":
+		return float64(r.IntN(100000)) / 100, nil
+	case "Boolean":
+		return r.IntN(2) == 1, nil
+	case "ID":
+		return strconv.Itoa(r.IntN(100000) + 1), nil
+	case "UUID":
+		return kinds["uuid"](r), nil
+	case "Time", "DateTime":
+		return epoch.Add(time.Duration(r.Int64N(int64(365 * 24 * time.Hour)))).Truncate(time.Second), nil
+	case "Date":
+		return epoch.AddDate(0, 0, r.IntN(365)).Format(time.DateOnly), nil
+	case "Duration":
+		return time.Duration(r.IntN(24*60)+1) * time.Minute, nil
+	case "Map", "Any":
+		return map[string]any{}, nil
+	}
+	return kinds[kindForField(field.Name)](r), nil
+}
+
+// epoch is the start of the year the times are picked in.
+var epoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// set assigns value to v, converting it to the Go type of v.
+func set(v reflect.Value, value any, path string) error {
+	rv := reflect.ValueOf(value)
+	if rv.Type().AssignableTo(v.Type()) {
+		v.Set(rv)
+		return nil
+	}
+	if v.CanAddr() {
+		switch u := v.Addr().Interface().(type) {
+		case graphql.Unmarshaler:
+			return u.UnmarshalGQL(marshalled(value))
+		case encoding.TextUnmarshaler:
+			return u.UnmarshalText([]byte(fmt.Sprint(marshalled(value))))
+		}
+	}
+
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(fmt.Sprint(marshalled(value)))
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch value := value.(type) {
+		case int64:
+			n = value
+		case time.Duration:
+			n = int64(value)
+		default:
+			n, err = strconv.ParseInt(fmt.Sprint(value), 10, 64)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		n, err = strconv.ParseUint(fmt.Sprint(value), 10, 64)
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		n, err = strconv.ParseFloat(fmt.Sprint(value), 64)
+		v.SetFloat(n)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(fmt.Sprint(value))
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("mockruntime: %s: can't fill %s with %v", path, v.Type(), value)
+	}
+	if err != nil {
+		return fmt.Errorf("mockruntime: %s: %w", path, err)
+	}
+	return nil
+}
+
+// marshalled returns value as it would be sent over the wire.
+func marshalled(value any) any {
+	switch value := value.(type) {
+	case time.Time:
+		return value.Format(time.RFC3339)
+	case time.Duration:
+		return fmt.Sprintf("PT%dM", int64(value/time.Minute))
+	}
+	return value
+}
+
+func directiveArg(field *ast.FieldDefinition, directive, arg string) (string, bool) {
+	if field == nil {
+		return "", false
+	}
+	d := field.Directives.ForName(directive)
+	if d == nil {
+		return "", false
+	}
+	a := d.Arguments.ForName(arg)
+	if a == nil || a.Value == nil || a.Value.Kind == ast.NullValue {
+		return "", false
+	}
+	return a.Value.Raw, true
+}
+
+// random returns the random source of the value at path.
+func random(seed uint64, path string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(path))
+	return rand.New(rand.NewPCG(seed, h.Sum64()))
+}
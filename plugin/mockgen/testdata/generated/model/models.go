@@ -0,0 +1,116 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type Post struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Author *User  `json:"author"`
+}
+
+func (Post) IsSearchResult() {}
+
+func (Post) IsNode()            {}
+func (this Post) GetID() string { return this.ID }
+
+type Query struct {
+}
+
+type Subscription struct {
+}
+
+type User struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Email         string        `json:"email"`
+	Website       *string       `json:"website,omitempty"`
+	Status        string        `json:"status"`
+	Role          Role          `json:"role"`
+	Score         float64       `json:"score"`
+	Age           int           `json:"age"`
+	Admin         bool          `json:"admin"`
+	Token         uuid.UUID     `json:"token"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	SessionLength time.Duration `json:"sessionLength"`
+	Friends       []*User       `json:"friends"`
+	Posts         []*Post       `json:"posts"`
+}
+
+func (User) IsSearchResult() {}
+
+func (User) IsNode()            {}
+func (this User) GetID() string { return this.ID }
+
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleMember Role = "MEMBER"
+)
+
+var AllRole = []Role{
+	RoleAdmin,
+	RoleMember,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleAdmin, RoleMember:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,110 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/plugin/mockgen/mockruntime"
+	"github.com/99designs/gqlgen/plugin/mockgen/testdata/generated"
+	"github.com/99designs/gqlgen/plugin/mockgen/testdata/generated/model"
+)
+
+// Resolver answers every field with fake data.
+type Resolver struct {
+	Faker *mockruntime.Faker
+}
+
+// NewResolver returns a Resolver returning the data picked by opts.
+func NewResolver(opts mockruntime.Options) *Resolver {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	return &Resolver{Faker: mockruntime.New(schema, mockTypes, mockFields, opts)}
+}
+
+// Config returns the config of an executable schema serving fake data, its directives do nothing.
+func Config(opts mockruntime.Options) generated.Config {
+	return generated.Config{
+		Resolvers: NewResolver(opts),
+		Directives: generated.DirectiveRoot{
+			Auth: func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error) {
+				return next(ctx)
+			},
+			Fake: func(ctx context.Context, obj any, next graphql.Resolver, kind string) (res any, err error) {
+				return next(ctx)
+			},
+			Mock: func(ctx context.Context, obj any, next graphql.Resolver, value *string, length *int) (res any, err error) {
+				return next(ctx)
+			},
+		},
+	}
+}
+
+var mockTypes = map[string]reflect.Type{
+	"Post": reflect.TypeOf((*model.Post)(nil)).Elem(),
+	"User": reflect.TypeOf((*model.User)(nil)).Elem(),
+}
+
+var mockFields = map[string]map[string]string{
+	"Post": {
+		"author": "Author",
+		"id":     "ID",
+		"title":  "Title",
+	},
+	"User": {
+		"admin":         "Admin",
+		"age":           "Age",
+		"createdAt":     "CreatedAt",
+		"email":         "Email",
+		"friends":       "Friends",
+		"id":            "ID",
+		"name":          "Name",
+		"role":          "Role",
+		"score":         "Score",
+		"sessionLength": "SessionLength",
+		"status":        "Status",
+		"token":         "Token",
+		"website":       "Website",
+	},
+}
+
+func (r *Resolver) Query() generated.QueryResolver {
+	return &resolverQuery{r}
+}
+func (r *Resolver) Subscription() generated.SubscriptionResolver {
+	return &resolverSubscription{r}
+}
+func (r *Resolver) User() generated.UserResolver {
+	return &resolverUser{r}
+}
+
+type resolverQuery struct{ *Resolver }
+
+func (r *resolverQuery) Users(ctx context.Context) ([]*model.User, error) {
+	return mockruntime.Value[[]*model.User](ctx, r.Faker)
+}
+func (r *resolverQuery) User(ctx context.Context, id string) (*model.User, error) {
+	return mockruntime.Value[*model.User](ctx, r.Faker)
+}
+func (r *resolverQuery) Me(ctx context.Context) (*model.User, error) {
+	return mockruntime.Value[*model.User](ctx, r.Faker)
+}
+func (r *resolverQuery) Search(ctx context.Context) ([]model.SearchResult, error) {
+	return mockruntime.Value[[]model.SearchResult](ctx, r.Faker)
+}
+func (r *resolverQuery) Node(ctx context.Context) (model.Node, error) {
+	return mockruntime.Value[model.Node](ctx, r.Faker)
+}
+
+type resolverSubscription struct{ *Resolver }
+
+func (r *resolverSubscription) UserCreated(ctx context.Context) (<-chan *model.User, error) {
+	return mockruntime.Stream[*model.User](ctx, r.Faker)
+}
+
+type resolverUser struct{ *Resolver }
+
+func (r *resolverUser) Posts(ctx context.Context, obj *model.User) ([]*model.Post, error) {
+	return mockruntime.Value[[]*model.Post](ctx, r.Faker)
+}
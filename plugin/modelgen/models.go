@@ -41,6 +41,7 @@ type ModelBuild struct {
 	Models      []*Object
 	Enums       []*Enum
 	Scalars     []string
+	Connections []*Connection
 }
 
 type Interface struct {
@@ -59,6 +60,14 @@ type Object struct {
 	Implements  []string
 }
 
+// Connection is a connection type generated for a type marked with @connection, along with its
+// edge type. Both are aliases of the generic types in the graphql package.
+type Connection struct {
+	Name string
+	Edge string
+	Node types.Type
+}
+
 type Field struct {
 	Description string
 	// Name is the field's name as it appears in the schema
@@ -141,8 +150,22 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 		}
 	}
 
+	connectionTypes := map[string]bool{}
+	for _, c := range config.Connections(cfg.Schema) {
+		if cfg.Models.UserDefined(c.Connection) || cfg.Models.UserDefined(c.Edge) {
+			continue
+		}
+		node, err := m.connectionNode(cfg, binder, c)
+		if err != nil {
+			return err
+		}
+		b.Connections = append(b.Connections, &Connection{Name: c.Connection, Edge: c.Edge, Node: node})
+		connectionTypes[c.Connection] = true
+		connectionTypes[c.Edge] = true
+	}
+
 	for _, schemaType := range cfg.Schema.Types {
-		if cfg.Models.UserDefined(schemaType.Name) {
+		if cfg.Models.UserDefined(schemaType.Name) || connectionTypes[schemaType.Name] {
 			continue
 		}
 		switch schemaType.Kind {
@@ -214,8 +237,13 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 	for _, it := range b.Scalars {
 		cfg.Models.Add(it, "github.com/99designs/gqlgen/graphql.String")
 	}
+	for _, it := range b.Connections {
+		cfg.Models.Add(it.Name, cfg.Model.GetImportPath()+"."+templates.ToGoModelName(it.Name))
+		cfg.Models.Add(it.Edge, cfg.Model.GetImportPath()+"."+templates.ToGoModelName(it.Edge))
+	}
 
-	if len(b.Models) == 0 && len(b.Enums) == 0 && len(b.Interfaces) == 0 && len(b.Scalars) == 0 {
+	if len(b.Models) == 0 && len(b.Enums) == 0 && len(b.Interfaces) == 0 && len(b.Scalars) == 0 &&
+		len(b.Connections) == 0 {
 		return nil
 	}
 
@@ -373,6 +401,24 @@ func (m *Plugin) generateFields(
 	return fields, nil
 }
 
+// connectionNode returns the Go type of the nodes of a connection, the same type a field of the
+// node type would have, with structs as pointers.
+func (m *Plugin) connectionNode(
+	cfg *config.Config,
+	binder *config.Binder,
+	c config.Connection,
+) (types.Type, error) {
+	edge := cfg.Schema.Types[c.Edge]
+	f, err := m.generateField(cfg, binder, edge, edge.Fields.ForName("node"))
+	if err != nil {
+		return nil, err
+	}
+	if _, isPointer := f.Type.(*types.Pointer); !isPointer && isStruct(f.Type) {
+		return types.NewPointer(f.Type), nil
+	}
+	return f.Type, nil
+}
+
 func (m *Plugin) generateField(
 	cfg *config.Config,
 	binder *config.Binder,
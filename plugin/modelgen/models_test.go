@@ -21,6 +21,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/plugin/modelgen/internal/extrafields"
 	"github.com/99designs/gqlgen/plugin/modelgen/out"
+	"github.com/99designs/gqlgen/plugin/modelgen/out_connections"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitempty_tag_false"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitempty_tag_false_omitzero_tag_false"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitempty_tag_false_omitzero_tag_nil"
@@ -389,6 +390,24 @@ func TestModelGenerationOmitResolverFields(t *testing.T) {
 	require.NotContains(t, string(generated), "ResolverField")
 }
 
+func TestModelGenerationConnections(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen_connections.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	p := Plugin{
+		MutateHook: mutateHook,
+		FieldHook:  DefaultFieldMutateHook,
+	}
+	require.NoError(t, p.MutateConfig(cfg))
+	require.NoError(t, goBuild(t, "./out_connections/"))
+
+	var users out_connections.UserConnection = graphql.Connection[*out_connections.User]{}
+	var results out_connections.ResultEdge = graphql.Edge[out_connections.Result]{}
+	_, _ = users, results
+	require.Equal(t, "github.com/99designs/gqlgen/plugin/modelgen/out_connections.UserConnection", cfg.Models["UserConnection"].Model[0])
+	require.Equal(t, "github.com/99designs/gqlgen/graphql.PageInfo", cfg.Models["PageInfo"].Model[0])
+}
+
 func TestModelGenerationStructFieldPointers(t *testing.T) {
 	cfg, err := config.LoadConfig("testdata/gqlgen_struct_field_pointers.yml")
 	require.NoError(t, err)
@@ -0,0 +1,35 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_connections
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type Result interface {
+	IsResult()
+}
+
+type Post struct {
+	Title string `json:"title" database:"Posttitle"`
+}
+
+func (Post) IsResult() {}
+
+type Query struct {
+}
+
+type User struct {
+	ID   string `json:"id" database:"Userid"`
+	Name string `json:"name" database:"Username"`
+}
+
+func (User) IsResult() {}
+
+type ResultConnection = graphql.Connection[Result]
+
+type ResultEdge = graphql.Edge[Result]
+
+type UserConnection = graphql.Connection[*User]
+
+type UserEdge = graphql.Edge[*User]
@@ -11,11 +11,13 @@ import (
 	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/plugin/mockgen"
 	"github.com/99designs/gqlgen/plugin/stubgen"
 )
 
 func main() {
 	stub := flag.String("stub", "", "name of stub file to generate")
+	mock := flag.String("mock", "", "name of mock file to generate")
 	cfgPath := flag.String("config", "", "path to config file (use default if omitted)")
 	flag.Parse()
 
@@ -39,6 +41,9 @@ func main() {
 	if *stub != "" {
 		options = append(options, api.AddPlugin(stubgen.New(*stub, "Stub")))
 	}
+	if *mock != "" {
+		options = append(options, api.AddPlugin(mockgen.New(*mock, "Resolver")))
+	}
 
 	err = api.Generate(cfg, options...)
 	if err != nil {